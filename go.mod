@@ -7,6 +7,7 @@ require (
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.40.1
 	github.com/rundeck/go-rundeck/rundeck v1.2.0
 	github.com/rundeck/go-rundeck/rundeck-v2 v1.2.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,269 @@
+package rundeck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// =============================================================================
+// STRUCTURED ACL POLICY RULES
+// =============================================================================
+//
+// rundeck_acl_policy accepts either a raw YAML policy string or a list of
+// structured "rule" blocks. Each rule block is one YAML document of the
+// policy; the provider renders the blocks to canonical YAML so the text sent
+// to Rundeck is stable regardless of how the blocks are written.
+//
+//   rule {                              description: Developers
+//     description = "Developers"        context:
+//     context {                           project: .*
+//       project = ".*"           =>     for:
+//     }                                   job:
+//     for {                                 - allow:
+//       resource_type = "job"                   - read
+//       allow         = ["read"]        by:
+//     }                                   group:
+//     by {                                  - dev
+//       group = ["dev"]
+//     }
+//   }
+// =============================================================================
+
+type aclPolicyRuleModel struct {
+	Description types.String `tfsdk:"description"`
+	Context     types.List   `tfsdk:"context"`
+	For         types.List   `tfsdk:"for"`
+	By          types.List   `tfsdk:"by"`
+}
+
+type aclPolicyContextModel struct {
+	Project     types.String `tfsdk:"project"`
+	Application types.String `tfsdk:"application"`
+}
+
+type aclPolicyForModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	Allow        types.List   `tfsdk:"allow"`
+	Deny         types.List   `tfsdk:"deny"`
+	Match        types.Map    `tfsdk:"match"`
+	Equals       types.Map    `tfsdk:"equals"`
+	Contains     types.Map    `tfsdk:"contains"`
+}
+
+type aclPolicyByModel struct {
+	Group    types.List `tfsdk:"group"`
+	Username types.List `tfsdk:"username"`
+	Urn      types.List `tfsdk:"urn"`
+}
+
+// aclPolicyDocument is one rendered YAML document. Field order matches the
+// layout Rundeck uses in its own examples.
+type aclPolicyDocument struct {
+	Description string                             `yaml:"description"`
+	Context     map[string]string                  `yaml:"context"`
+	For         map[string][]aclPolicyDocumentRule `yaml:"for"`
+	By          map[string][]string                `yaml:"by"`
+}
+
+type aclPolicyDocumentRule struct {
+	Match    map[string]string `yaml:"match,omitempty"`
+	Equals   map[string]string `yaml:"equals,omitempty"`
+	Contains map[string]string `yaml:"contains,omitempty"`
+	Allow    []string          `yaml:"allow,omitempty"`
+	Deny     []string          `yaml:"deny,omitempty"`
+}
+
+// aclPolicyRuleNestedBlock returns the schema for the structured rule blocks.
+func aclPolicyRuleNestedBlock() schema.ListNestedBlock {
+	stringList := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Description: description,
+			ElementType: types.StringType,
+			Optional:    true,
+		}
+	}
+	stringMap := func(description string) schema.MapAttribute {
+		return schema.MapAttribute{
+			Description: description,
+			ElementType: types.StringType,
+			Optional:    true,
+		}
+	}
+
+	return schema.ListNestedBlock{
+		Description: "Structured ACL policy rules. Each rule is rendered as one YAML document of the policy. Conflicts with policy.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"description": schema.StringAttribute{
+					Description: "Description of the rule.",
+					Required:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"context": schema.ListNestedBlock{
+					Description: "The context the rule applies to. Set exactly one of project or application.",
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"project": schema.StringAttribute{
+								Description: "Regular expression matching the project names the rule applies to.",
+								Optional:    true,
+							},
+							"application": schema.StringAttribute{
+								Description: "Application context, normally \"rundeck\".",
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"for": schema.ListNestedBlock{
+					Description: "Access granted or denied for a resource kind. Blocks with the same resource_type are combined in order.",
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"resource_type": schema.StringAttribute{
+								Description: "Resource kind, e.g. job, node, adhoc, resource, project or storage.",
+								Required:    true,
+							},
+							"allow":    stringList("Actions to allow."),
+							"deny":     stringList("Actions to deny."),
+							"match":    stringMap("Properties matched by regular expression."),
+							"equals":   stringMap("Properties matched exactly."),
+							"contains": stringMap("Properties that must contain the given value."),
+						},
+					},
+				},
+				"by": schema.ListNestedBlock{
+					Description: "Subjects the rule applies to.",
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"group":    stringList("Group names."),
+							"username": stringList("User names."),
+							"urn":      stringList("Subject URNs."),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// renderAclPolicyRules renders structured rule blocks to canonical multi-document
+// YAML. The caller must make sure the list is fully known.
+func renderAclPolicyRules(ctx context.Context, rules types.List) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var ruleModels []aclPolicyRuleModel
+	diags.Append(rules.ElementsAs(ctx, &ruleModels, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	for _, rule := range ruleModels {
+		doc := aclPolicyDocument{
+			Description: rule.Description.ValueString(),
+			Context:     map[string]string{},
+			For:         map[string][]aclPolicyDocumentRule{},
+			By:          map[string][]string{},
+		}
+
+		var contexts []aclPolicyContextModel
+		diags.Append(rule.Context.ElementsAs(ctx, &contexts, false)...)
+		for _, c := range contexts {
+			if !c.Project.IsNull() {
+				doc.Context["project"] = c.Project.ValueString()
+			}
+			if !c.Application.IsNull() {
+				doc.Context["application"] = c.Application.ValueString()
+			}
+		}
+
+		var fors []aclPolicyForModel
+		diags.Append(rule.For.ElementsAs(ctx, &fors, false)...)
+		for _, f := range fors {
+			entry := aclPolicyDocumentRule{}
+			diags.Append(aclStringMap(ctx, f.Match, &entry.Match)...)
+			diags.Append(aclStringMap(ctx, f.Equals, &entry.Equals)...)
+			diags.Append(aclStringMap(ctx, f.Contains, &entry.Contains)...)
+			diags.Append(aclStringList(ctx, f.Allow, &entry.Allow)...)
+			diags.Append(aclStringList(ctx, f.Deny, &entry.Deny)...)
+			resourceType := f.ResourceType.ValueString()
+			doc.For[resourceType] = append(doc.For[resourceType], entry)
+		}
+
+		var bys []aclPolicyByModel
+		diags.Append(rule.By.ElementsAs(ctx, &bys, false)...)
+		for _, b := range bys {
+			for key, list := range map[string]types.List{"group": b.Group, "username": b.Username, "urn": b.Urn} {
+				var values []string
+				diags.Append(aclStringList(ctx, list, &values)...)
+				if len(values) > 0 {
+					doc.By[key] = values
+				}
+			}
+		}
+
+		if diags.HasError() {
+			return "", diags
+		}
+
+		if err := encoder.Encode(doc); err != nil {
+			diags.AddError("Error rendering ACL policy", fmt.Sprintf("Could not render rule %q to YAML: %s", doc.Description, err.Error()))
+			return "", diags
+		}
+	}
+
+	if err := encoder.Close(); err != nil {
+		diags.AddError("Error rendering ACL policy", err.Error())
+		return "", diags
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), diags
+}
+
+func aclStringList(ctx context.Context, list types.List, target *[]string) diag.Diagnostics {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	return list.ElementsAs(ctx, target, false)
+}
+
+func aclStringMap(ctx context.Context, m types.Map, target *map[string]string) diag.Diagnostics {
+	if m.IsNull() || m.IsUnknown() || len(m.Elements()) == 0 {
+		return nil
+	}
+	return m.ElementsAs(ctx, target, false)
+}
+
+// aclPolicyValueKnown reports whether a value and everything nested in it is known.
+func aclPolicyValueKnown(ctx context.Context, v attr.Value) bool {
+	tfValue, err := v.ToTerraformValue(ctx)
+	if err != nil {
+		return false
+	}
+	return tfValue.IsFullyKnown()
+}
@@ -0,0 +1,112 @@
+package rundeck
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRenderAclPolicyRules(t *testing.T) {
+	ctx := context.Background()
+	block := aclPolicyRuleNestedBlock()
+	nestedType := func(name string) attr.Type {
+		return block.NestedObject.Blocks[name].(schema.ListNestedBlock).NestedObject.Type()
+	}
+	list := func(elemType attr.Type, elems interface{}) types.List {
+		v, diags := types.ListValueFrom(ctx, elemType, elems)
+		if diags.HasError() {
+			t.Fatalf("building list: %v", diags)
+		}
+		return v
+	}
+	strings := func(values ...string) types.List {
+		return list(types.StringType, values)
+	}
+	stringMap := func(values map[string]string) types.Map {
+		v, diags := types.MapValueFrom(ctx, types.StringType, values)
+		if diags.HasError() {
+			t.Fatalf("building map: %v", diags)
+		}
+		return v
+	}
+	noMap := types.MapNull(types.StringType)
+	noList := types.ListNull(types.StringType)
+
+	rules := list(block.NestedObject.Type(), []aclPolicyRuleModel{
+		{
+			Description: types.StringValue("Developers"),
+			Context: list(nestedType("context"), []aclPolicyContextModel{
+				{Project: types.StringValue(".*"), Application: types.StringNull()},
+			}),
+			For: list(nestedType("for"), []aclPolicyForModel{
+				{ResourceType: types.StringValue("job"), Allow: strings("read", "run"), Deny: noList, Match: stringMap(map[string]string{"group": "dev/.*"}), Equals: noMap, Contains: noMap},
+				{ResourceType: types.StringValue("adhoc"), Allow: noList, Deny: strings("run"), Match: noMap, Equals: noMap, Contains: noMap},
+				{ResourceType: types.StringValue("job"), Allow: strings("read"), Deny: noList, Match: noMap, Equals: noMap, Contains: noMap},
+			}),
+			By: list(nestedType("by"), []aclPolicyByModel{
+				{Group: strings("dev"), Username: noList, Urn: noList},
+			}),
+		},
+		{
+			Description: types.StringValue("Developers application access"),
+			Context: list(nestedType("context"), []aclPolicyContextModel{
+				{Project: types.StringNull(), Application: types.StringValue("rundeck")},
+			}),
+			For: list(nestedType("for"), []aclPolicyForModel{
+				{ResourceType: types.StringValue("project"), Allow: strings("read"), Deny: noList, Match: noMap, Equals: stringMap(map[string]string{"name": "dev"}), Contains: noMap},
+			}),
+			By: list(nestedType("by"), []aclPolicyByModel{
+				{Group: strings("dev"), Username: strings("alice"), Urn: noList},
+			}),
+		},
+	})
+
+	got, diags := renderAclPolicyRules(ctx, rules)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	want := `description: Developers
+context:
+  project: .*
+for:
+  adhoc:
+    - deny:
+        - run
+  job:
+    - match:
+        group: dev/.*
+      allow:
+        - read
+        - run
+    - allow:
+        - read
+by:
+  group:
+    - dev
+---
+description: Developers application access
+context:
+  application: rundeck
+for:
+  project:
+    - equals:
+        name: dev
+      allow:
+        - read
+by:
+  group:
+    - dev
+  username:
+    - alice`
+	if got != want {
+		t.Fatalf("rendered policy mismatch\nexpected:\n%s\n\ngot:\n%s", want, got)
+	}
+
+	if errs := validateAclPolicy(got, false); len(errs) > 0 {
+		t.Errorf("rendered policy is not valid: %v", errs)
+	}
+}
@@ -0,0 +1,259 @@
+package rundeck
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// =============================================================================
+// ACL POLICY VALIDATION
+// =============================================================================
+//
+// Rundeck only validates ACL policies when they are stored, so a bad policy
+// used to fail during apply, possibly after other resources had already been
+// changed. These helpers mirror the structural checks Rundeck performs on
+// each YAML document so problems are reported at plan time instead.
+//
+// Rundeck has no dry-run validation endpoint, and config validation runs
+// before the provider is configured, so the checks are done locally.
+// =============================================================================
+
+// aclPolicyValidationError describes a single problem found in an ACL policy.
+type aclPolicyValidationError struct {
+	Document int    // 1-based index of the YAML document within the policy
+	Line     int    // 1-based line number within the policy text (0 if unknown)
+	Section  string // Section of the document, e.g. "for.job[0]"
+	Message  string
+}
+
+func (e aclPolicyValidationError) String() string {
+	location := fmt.Sprintf("Policy document %d", e.Document)
+	if e.Line > 0 {
+		location += fmt.Sprintf(" (line %d)", e.Line)
+	}
+	if e.Section != "" {
+		location += fmt.Sprintf(", section '%s'", e.Section)
+	}
+	return location + ": " + e.Message
+}
+
+// aclRuleKeys are the keys allowed in a single "for" rule.
+var aclRuleKeys = map[string]bool{
+	"allow":    true,
+	"deny":     true,
+	"match":    true,
+	"equals":   true,
+	"contains": true,
+	"subset":   true,
+}
+
+// aclSubjectKeys are the keys allowed in a "by" or "notBy" section.
+var aclSubjectKeys = map[string]bool{
+	"group":    true,
+	"username": true,
+	"urn":      true,
+}
+
+// validateAclPolicy checks every document of a (possibly multi-document) ACL
+// policy. Project-scoped policies get their context from the project they are
+// stored in, so they must not declare a context section of their own.
+func validateAclPolicy(policy string, projectScoped bool) []aclPolicyValidationError {
+	var errs []aclPolicyValidationError
+
+	decoder := yaml.NewDecoder(strings.NewReader(policy))
+	docIndex := 0
+	for {
+		var doc yaml.Node
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		docIndex++
+		if err != nil {
+			errs = append(errs, aclPolicyValidationError{
+				Document: docIndex,
+				Message:  fmt.Sprintf("invalid YAML: %s", err.Error()),
+			})
+			// The decoder cannot recover from a syntax error
+			break
+		}
+
+		// Skip empty documents (e.g. a trailing "---")
+		if len(doc.Content) == 0 || doc.Content[0].Tag == "!!null" {
+			docIndex--
+			continue
+		}
+
+		errs = append(errs, validateAclPolicyDocument(docIndex, doc.Content[0], projectScoped)...)
+	}
+
+	if docIndex == 0 && len(errs) == 0 {
+		errs = append(errs, aclPolicyValidationError{
+			Document: 1,
+			Message:  "policy does not contain any documents",
+		})
+	}
+
+	return errs
+}
+
+func validateAclPolicyDocument(docIndex int, root *yaml.Node, projectScoped bool) []aclPolicyValidationError {
+	var errs []aclPolicyValidationError
+	addErr := func(node *yaml.Node, section, format string, args ...interface{}) {
+		line := 0
+		if node != nil {
+			line = node.Line
+		}
+		errs = append(errs, aclPolicyValidationError{
+			Document: docIndex,
+			Line:     line,
+			Section:  section,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	if root.Kind != yaml.MappingNode {
+		addErr(root, "", "document must be a YAML mapping")
+		return errs
+	}
+
+	sections := map[string]*yaml.Node{}
+	keys := map[string]*yaml.Node{}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		sections[root.Content[i].Value] = root.Content[i+1]
+		keys[root.Content[i].Value] = root.Content[i]
+	}
+
+	// description
+	if desc, ok := sections["description"]; !ok {
+		addErr(root, "description", "required 'description:' was not found")
+	} else if desc.Kind != yaml.ScalarNode || desc.Tag == "!!null" {
+		addErr(desc, "description", "must be a string")
+	}
+
+	// context
+	ctxNode, hasContext := sections["context"]
+	switch {
+	case projectScoped && hasContext:
+		addErr(keys["context"], "context", "must not be specified in a project ACL policy; the context is always the project the policy is stored in")
+	case !projectScoped && !hasContext:
+		addErr(root, "context", "required 'context:' section was not found")
+	case !projectScoped:
+		if ctxNode.Kind != yaml.MappingNode || len(ctxNode.Content) == 0 {
+			addErr(ctxNode, "context", "must be a mapping containing either 'project' or 'application'")
+			break
+		}
+		if len(ctxNode.Content) != 2 {
+			addErr(ctxNode, "context", "must contain exactly one of 'project' or 'application'")
+			break
+		}
+		key := ctxNode.Content[0]
+		if key.Value != "project" && key.Value != "application" {
+			addErr(key, "context", "unexpected key '%s', expected 'project' or 'application'", key.Value)
+		} else if ctxNode.Content[1].Kind != yaml.ScalarNode {
+			addErr(ctxNode.Content[1], "context."+key.Value, "must be a string")
+		}
+	}
+
+	// by / notBy
+	_, hasBy := sections["by"]
+	_, hasNotBy := sections["notBy"]
+	if !hasBy && !hasNotBy {
+		addErr(root, "by", "required 'by:' or 'notBy:' section was not found")
+	}
+	for _, name := range []string{"by", "notBy"} {
+		node, ok := sections[name]
+		if !ok {
+			continue
+		}
+		if node.Kind != yaml.MappingNode || len(node.Content) == 0 {
+			addErr(node, name, "must be a mapping containing 'group', 'username' or 'urn'")
+			continue
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if !aclSubjectKeys[key.Value] {
+				addErr(key, name, "unexpected key '%s', expected 'group', 'username' or 'urn'", key.Value)
+				continue
+			}
+			if !isAclStringOrList(node.Content[i+1]) {
+				addErr(node.Content[i+1], name+"."+key.Value, "must be a string or a list of strings")
+			}
+		}
+	}
+
+	// for
+	forNode, ok := sections["for"]
+	if !ok {
+		addErr(root, "for", "required 'for:' section was not found")
+		return errs
+	}
+	if forNode.Kind != yaml.MappingNode || len(forNode.Content) == 0 {
+		addErr(forNode, "for", "must be a non-empty mapping of resource types to rule lists")
+		return errs
+	}
+	for i := 0; i+1 < len(forNode.Content); i += 2 {
+		resourceType := forNode.Content[i].Value
+		rules := forNode.Content[i+1]
+		section := "for." + resourceType
+		if rules.Kind != yaml.SequenceNode || len(rules.Content) == 0 {
+			addErr(rules, section, "must be a non-empty list of rules")
+			continue
+		}
+		for j, rule := range rules.Content {
+			ruleSection := fmt.Sprintf("%s[%d]", section, j)
+			if rule.Kind != yaml.MappingNode {
+				addErr(rule, ruleSection, "rule must be a mapping")
+				continue
+			}
+			hasAction := false
+			for k := 0; k+1 < len(rule.Content); k += 2 {
+				key := rule.Content[k]
+				value := rule.Content[k+1]
+				if !aclRuleKeys[key.Value] {
+					addErr(key, ruleSection, "unexpected key '%s', expected one of allow, deny, match, equals, contains, subset", key.Value)
+					continue
+				}
+				switch key.Value {
+				case "allow", "deny":
+					hasAction = true
+					if !isAclStringOrList(value) {
+						addErr(value, ruleSection+"."+key.Value, "must be a string or a list of strings")
+					}
+				default:
+					if value.Kind != yaml.MappingNode || len(value.Content) == 0 {
+						addErr(value, ruleSection+"."+key.Value, "must be a non-empty mapping of properties")
+					}
+				}
+			}
+			if !hasAction {
+				addErr(rule, ruleSection, "rule must contain 'allow' or 'deny'")
+			}
+		}
+	}
+
+	return errs
+}
+
+// isAclStringOrList reports whether a node is a scalar or a non-empty list of scalars.
+func isAclStringOrList(node *yaml.Node) bool {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return node.Tag != "!!null"
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 {
+			return false
+		}
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
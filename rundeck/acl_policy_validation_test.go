@@ -0,0 +1,129 @@
+package rundeck
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateAclPolicy_valid(t *testing.T) {
+	for name, policy := range map[string]string{
+		"basic":          basicAclPolicy,
+		"initial":        aclPolicyInitial,
+		"updated":        aclPolicyUpdated,
+		"multi-document": aclPolicyInitial + "\n---\n" + aclPolicyUpdated + "\n---\n",
+	} {
+		if errs := validateAclPolicy(policy, false); len(errs) > 0 {
+			t.Errorf("%s: expected no errors, got %v", name, errs)
+		}
+	}
+
+	if errs := validateAclPolicy(projectAclPolicyInitial, true); len(errs) > 0 {
+		t.Errorf("project policy: expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateAclPolicy_errors(t *testing.T) {
+	cases := []struct {
+		name          string
+		policy        string
+		projectScoped bool
+		want          []string
+	}{
+		{
+			name:   "empty",
+			policy: "",
+			want:   []string{"Policy document 1: policy does not contain any documents"},
+		},
+		{
+			name:   "invalid yaml",
+			policy: "description: x\n  context: [",
+			want:   []string{"Policy document 1: invalid YAML"},
+		},
+		{
+			name: "missing sections",
+			policy: `description: missing everything else
+`,
+			want: []string{
+				"section 'context': required 'context:' section was not found",
+				"section 'by': required 'by:' or 'notBy:' section was not found",
+				"section 'for': required 'for:' section was not found",
+			},
+		},
+		{
+			name: "bad context key",
+			policy: `description: bad context
+context:
+  system: rundeck
+for:
+  resource:
+    - allow: '*'
+by:
+  group: admin`,
+			want: []string{"Policy document 1 (line 3), section 'context': unexpected key 'system'"},
+		},
+		{
+			name: "rule without action in second document",
+			policy: aclPolicyInitial + `
+---
+description: no action
+context:
+  application: rundeck
+for:
+  project:
+    - match:
+        name: foo
+by:
+  username: bob`,
+			want: []string{"Policy document 2 (line 17), section 'for.project[0]': rule must contain 'allow' or 'deny'"},
+		},
+		{
+			name: "bad rule key and selector",
+			policy: `description: typo
+context:
+  project: '.*'
+for:
+  job:
+    - allows: [read]
+      equals: foo
+by:
+  group: [dev, ops]`,
+			want: []string{
+				"(line 6), section 'for.job[0]': unexpected key 'allows'",
+				"(line 7), section 'for.job[0].equals': must be a non-empty mapping",
+				"section 'for.job[0]': rule must contain 'allow' or 'deny'",
+			},
+		},
+		{
+			name: "bad subject",
+			policy: `description: bad subject
+context:
+  project: '.*'
+for:
+  job:
+    - allow: read
+by:
+  role: admin`,
+			want: []string{"(line 8), section 'by': unexpected key 'role'"},
+		},
+		{
+			name:          "project policy with context",
+			policy:        aclPolicyInitial,
+			projectScoped: true,
+			want:          []string{"(line 2), section 'context': must not be specified in a project ACL policy"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateAclPolicy(tc.policy, tc.projectScoped)
+			if len(errs) != len(tc.want) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tc.want), len(errs), errs)
+			}
+			for i, want := range tc.want {
+				if got := errs[i].String(); !strings.Contains(got, want) {
+					t.Errorf("error %d: expected %q to contain %q", i, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package rundeck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// rundeckAPIRequest sends a JSON request to the Rundeck API and decodes the
+// JSON response into out (if not nil). apiPath is relative to /api/<version>/.
+// The HTTP status code is returned so callers can handle 404s.
+func rundeckAPIRequest(ctx context.Context, clients *RundeckClients, method, apiPath string, query url.Values, body interface{}, out interface{}) (int, error) {
+	var jsonBody []byte
+	if body != nil {
+		var err error
+		jsonBody, err = json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("could not encode request: %w", err)
+		}
+	}
+
+	resp, err := rundeckAPIRawRequest(ctx, clients, method, apiPath, query, "application/json", "application/json", jsonBody)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	if out != nil && len(resp.Body) > 0 {
+		if err := json.Unmarshal(resp.Body, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("could not parse response: %w", err)
+		}
+	}
+
+	return resp.StatusCode, nil
+}
+
+// rundeckAPIResponse is the result of a raw Rundeck API request.
+type rundeckAPIResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// rundeckAPIRawRequest sends a request with a raw body of the given content
+// type, for endpoints that exchange documents other than JSON (YAML node
+// definitions, archives, markdown files). A nil body sends no content.
+// Non-2xx responses are returned as errors along with the response.
+func rundeckAPIRawRequest(ctx context.Context, clients *RundeckClients, method, apiPath string, query url.Values, contentType, accept string, body []byte) (rundeckAPIResponse, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	httpResp, err := doRundeckAPIRequest(ctx, clients, method, apiPath, query, contentType, accept, reqBody)
+	if err != nil {
+		return rundeckAPIResponse{}, err
+	}
+	defer httpResp.Body.Close()
+
+	resp := rundeckAPIResponse{
+		StatusCode:  httpResp.StatusCode,
+		ContentType: httpResp.Header.Get("Content-Type"),
+	}
+	resp.Body, err = io.ReadAll(httpResp.Body)
+	if err != nil {
+		return resp, fmt.Errorf("could not read response: %w", err)
+	}
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		return resp, fmt.Errorf("API returned status %d: %s", httpResp.StatusCode, string(resp.Body))
+	}
+
+	return resp, nil
+}
+
+// rundeckAPIDownload streams the response of a GET request to w, so large
+// files such as project archives are not held in memory. The number of bytes
+// written is returned.
+func rundeckAPIDownload(ctx context.Context, clients *RundeckClients, apiPath string, query url.Values, accept string, w io.Writer) (int64, error) {
+	httpResp, err := doRundeckAPIRequest(ctx, clients, http.MethodGet, apiPath, query, "", accept, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode > 299 {
+		body, _ := io.ReadAll(httpResp.Body)
+		return 0, fmt.Errorf("API returned status %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	n, err := io.Copy(w, httpResp.Body)
+	if err != nil {
+		return n, fmt.Errorf("could not read response: %w", err)
+	}
+	return n, nil
+}
+
+// doRundeckAPIRequest sends an authenticated request to the Rundeck API. The
+// caller must close the response body.
+func doRundeckAPIRequest(ctx context.Context, clients *RundeckClients, method, apiPath string, query url.Values, contentType, accept string, body io.Reader) (*http.Response, error) {
+	apiURL := fmt.Sprintf("%s/api/%s/%s", clients.BaseURL, clients.APIVersion, strings.TrimPrefix(apiPath, "/"))
+	if len(query) > 0 {
+		apiURL += "?" + query.Encode()
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, apiURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+	if body != nil && contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	if accept != "" {
+		httpReq.Header.Set("Accept", accept)
+	}
+	httpReq.Header.Set("X-Rundeck-Auth-Token", clients.Token)
+
+	httpClient := &http.Client{}
+	return httpClient.Do(httpReq)
+}
@@ -0,0 +1,314 @@
+package rundeck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &jobDocumentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &jobDocumentDataSource{}
+)
+
+// NewJobDocumentDataSource is a helper function to simplify the provider implementation.
+func NewJobDocumentDataSource() datasource.DataSource {
+	return &jobDocumentDataSource{}
+}
+
+// jobDocumentDataSource renders the job document rundeck_job would send to
+// Rundeck. It takes the same arguments as rundeck_job and makes no API calls.
+type jobDocumentDataSource struct{}
+
+// jobDocumentDataSourceModel describes the data source data model.
+type jobDocumentDataSourceModel struct {
+	jobDocumentModel
+	JSON types.String `tfsdk:"json"`
+	YAML types.String `tfsdk:"yaml"`
+}
+
+// jobDocumentExcludedAttributes are rundeck_job arguments that only affect how
+// the resource is created and are not part of the job document.
+var jobDocumentExcludedAttributes = map[string]bool{
+	"adopt_existing": true,
+}
+
+// Metadata returns the data source type name.
+func (d *jobDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_document"
+}
+
+// Schema defines the schema for the data source. The arguments are those of
+// rundeck_job, converted from the resource schema so the two never diverge,
+// except for create-time options.
+func (d *jobDocumentDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	jobSchema := jobResourceSchema(ctx)
+
+	attributes := map[string]schema.Attribute{
+		"json": schema.StringAttribute{
+			Description: "The job document as JSON, exactly as rundeck_job would import it.",
+			Computed:    true,
+		},
+		"yaml": schema.StringAttribute{
+			Description: "The same job document as YAML.",
+			Computed:    true,
+		},
+	}
+	for name, a := range jobSchema.Attributes {
+		if jobDocumentExcludedAttributes[name] {
+			continue
+		}
+		converted, err := jobDocumentAttribute(a)
+		if err != nil {
+			resp.Diagnostics.AddError("Error building job document schema", fmt.Sprintf("Attribute %s: %s", name, err.Error()))
+			return
+		}
+		attributes[name] = converted
+	}
+
+	blocks := map[string]schema.Block{}
+	for name, b := range jobSchema.Blocks {
+		converted, err := jobDocumentBlock(b)
+		if err != nil {
+			resp.Diagnostics.AddError("Error building job document schema", fmt.Sprintf("Block %s: %s", name, err.Error()))
+			return
+		}
+		blocks[name] = converted
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Renders a Rundeck job as the JSON and YAML job document that rundeck_job would send, without contacting Rundeck.",
+		Attributes:  attributes,
+		Blocks:      blocks,
+	}
+}
+
+// ValidateConfig applies the rundeck_job configuration checks.
+func (d *jobDocumentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config jobDocumentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateJobConfig(ctx, &jobResourceModel{jobDocumentModel: config.jobDocumentModel}, &resp.Diagnostics)
+}
+
+// Read renders the job document.
+func (d *jobDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jobDocumentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill in the rundeck_job defaults for unset arguments, as a plan would
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for name, a := range jobResourceSchema(ctx).Attributes {
+		if jobDocumentExcludedAttributes[name] {
+			continue
+		}
+		resp.Diagnostics.Append(applyJobDocumentDefault(ctx, req, resp, path.Root(name), a)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := (&jobResource{}).planToJobJSON(ctx, &jobResourceModel{jobDocumentModel: config.jobDocumentModel})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rendering job document",
+			fmt.Sprintf("Could not convert job to JSON: %s", err.Error()),
+		)
+		return
+	}
+
+	// Render through a generic document so both formats share key order
+	raw, err := json.Marshal(job)
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering job document", err.Error())
+		return
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal(raw, &document); err != nil {
+		resp.Diagnostics.AddError("Error rendering job document", err.Error())
+		return
+	}
+
+	jsonDocument, err := renderJobDefinition(document, "json")
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering job document", err.Error())
+		return
+	}
+	yamlDocument, err := renderJobDefinition(document, "yaml")
+	if err != nil {
+		resp.Diagnostics.AddError("Error rendering job document", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(config.ProjectName.ValueString() + "/" + jobFullName(config.GroupName.ValueString(), config.Name.ValueString()))
+	config.JSON = types.StringValue(jsonDocument)
+	config.YAML = types.StringValue(yamlDocument)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// jobResourceSchema returns the rundeck_job resource schema.
+func jobResourceSchema(ctx context.Context) resourceschema.Schema {
+	var resp resource.SchemaResponse
+	(&jobResource{}).Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// applyJobDocumentDefault sets the resource default of an attribute that is
+// not set in the configuration.
+func applyJobDocumentDefault(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse, p path.Path, a resourceschema.Attribute) diag.Diagnostics {
+	switch a := a.(type) {
+	case resourceschema.BoolAttribute:
+		var v types.Bool
+		diags := req.Config.GetAttribute(ctx, p, &v)
+		if a.Default == nil || !v.IsNull() || diags.HasError() {
+			return diags
+		}
+		var dr defaults.BoolResponse
+		a.Default.DefaultBool(ctx, defaults.BoolRequest{Path: p}, &dr)
+		diags.Append(dr.Diagnostics...)
+		return append(diags, resp.State.SetAttribute(ctx, p, dr.PlanValue)...)
+	case resourceschema.StringAttribute:
+		var v types.String
+		diags := req.Config.GetAttribute(ctx, p, &v)
+		if a.Default == nil || !v.IsNull() || diags.HasError() {
+			return diags
+		}
+		var dr defaults.StringResponse
+		a.Default.DefaultString(ctx, defaults.StringRequest{Path: p}, &dr)
+		diags.Append(dr.Diagnostics...)
+		return append(diags, resp.State.SetAttribute(ctx, p, dr.PlanValue)...)
+	case resourceschema.Int64Attribute:
+		var v types.Int64
+		diags := req.Config.GetAttribute(ctx, p, &v)
+		if a.Default == nil || !v.IsNull() || diags.HasError() {
+			return diags
+		}
+		var dr defaults.Int64Response
+		a.Default.DefaultInt64(ctx, defaults.Int64Request{Path: p}, &dr)
+		diags.Append(dr.Diagnostics...)
+		return append(diags, resp.State.SetAttribute(ctx, p, dr.PlanValue)...)
+	}
+	return nil
+}
+
+// jobDocumentAttribute converts a rundeck_job attribute to its data source
+// equivalent. Plan modifiers and defaults have no data source counterpart;
+// defaults are applied in Read instead.
+func jobDocumentAttribute(a resourceschema.Attribute) (schema.Attribute, error) {
+	switch a := a.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Validators:          a.Validators,
+		}, nil
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Validators:          a.Validators,
+		}, nil
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Validators:          a.Validators,
+		}, nil
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{
+			ElementType:         a.ElementType,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Validators:          a.Validators,
+		}, nil
+	case resourceschema.MapAttribute:
+		return schema.MapAttribute{
+			ElementType:         a.ElementType,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Validators:          a.Validators,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported attribute type %T", a)
+}
+
+// jobDocumentBlock converts a rundeck_job block to its data source equivalent.
+func jobDocumentBlock(b resourceschema.Block) (schema.Block, error) {
+	list, ok := b.(resourceschema.ListNestedBlock)
+	if !ok {
+		return nil, fmt.Errorf("unsupported block type %T", b)
+	}
+
+	attributes := map[string]schema.Attribute{}
+	for name, a := range list.NestedObject.Attributes {
+		converted, err := jobDocumentAttribute(a)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", name, err)
+		}
+		attributes[name] = converted
+	}
+	blocks := map[string]schema.Block{}
+	for name, nested := range list.NestedObject.Blocks {
+		converted, err := jobDocumentBlock(nested)
+		if err != nil {
+			return nil, fmt.Errorf("block %s: %w", name, err)
+		}
+		blocks[name] = converted
+	}
+
+	return schema.ListNestedBlock{
+		Description:         list.Description,
+		MarkdownDescription: list.MarkdownDescription,
+		Validators:          list.Validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks:     blocks,
+			Validators: list.NestedObject.Validators,
+		},
+	}, nil
+}
@@ -0,0 +1,157 @@
+package rundeck
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestJobDocumentDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+
+	var resp datasource.SchemaResponse
+	(&jobDocumentDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("invalid schema: %v", diags)
+	}
+
+	// Every rundeck_job argument except create-time options must be accepted
+	jobSchema := jobResourceSchema(ctx)
+	for name := range jobSchema.Attributes {
+		_, ok := resp.Schema.Attributes[name]
+		if excluded := jobDocumentExcludedAttributes[name]; ok == excluded {
+			t.Errorf("attribute %s: expected present=%v", name, !excluded)
+		}
+	}
+	for name := range jobSchema.Blocks {
+		if _, ok := resp.Schema.Blocks[name]; !ok {
+			t.Errorf("missing block %s", name)
+		}
+	}
+	for _, name := range []string{"json", "yaml"} {
+		if a, ok := resp.Schema.Attributes[name]; !ok || !a.IsComputed() {
+			t.Errorf("expected computed attribute %s", name)
+		}
+	}
+}
+
+func TestJobDocumentDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	d := &jobDocumentDataSource{}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	// Build the configuration through a state, which can set single attributes
+	config := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	for p, v := range map[string]string{
+		"name":         "deploy",
+		"group_name":   "ops",
+		"project_name": "payments",
+		"description":  "Deploy the application",
+	} {
+		if diags := config.SetAttribute(ctx, path.Root(p), v); diags.HasError() {
+			t.Fatalf("could not set %s: %v", p, diags)
+		}
+	}
+	if diags := config.SetAttribute(ctx, path.Root("command").AtListIndex(0).AtName("shell_command"), "echo deploying"); diags.HasError() {
+		t.Fatalf("could not set command: %v", diags)
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: s, Raw: config.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var model jobDocumentDataSourceModel
+	if diags := resp.State.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("could not read state: %v", diags)
+	}
+	if model.ID.ValueString() != "payments/ops/deploy" {
+		t.Errorf("unexpected id %q", model.ID.ValueString())
+	}
+	for _, want := range []string{`"name": "deploy"`, `"group": "ops"`, `"loglevel": "INFO"`, `"executionEnabled": true`, `"strategy": "node-first"`, `"exec": "echo deploying"`} {
+		if !strings.Contains(model.JSON.ValueString(), want) {
+			t.Errorf("expected %s in JSON document:\n%s", want, model.JSON.ValueString())
+		}
+	}
+
+	// Both formats describe the same job
+	fromJSON, err := parseJobDefinition(model.JSON.ValueString())
+	if err != nil {
+		t.Fatalf("JSON document does not parse: %v", err)
+	}
+	fromYAML, err := parseJobDefinition(model.YAML.ValueString())
+	if err != nil {
+		t.Fatalf("YAML document does not parse: %v", err)
+	}
+	if !jobDefinitionMatches(fromJSON, fromYAML) || !jobDefinitionMatches(fromYAML, fromJSON) {
+		t.Errorf("JSON and YAML documents differ:\n%s\n%s", model.JSON.ValueString(), model.YAML.ValueString())
+	}
+}
+
+func TestAccJobDocumentDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccJobDefinitionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobDocumentDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rundeck_job_document.test", "id", "terraform-acc-test-job-document/ops/deploy"),
+					resource.TestMatchResourceAttr("data.rundeck_job_document.test", "json", regexp.MustCompile(`"name": "deploy"`)),
+					// Defaults of rundeck_job are applied to unset arguments
+					resource.TestMatchResourceAttr("data.rundeck_job_document.test", "json", regexp.MustCompile(`"loglevel": "INFO"`)),
+					resource.TestMatchResourceAttr("data.rundeck_job_document.test", "yaml", regexp.MustCompile(`(?m)^  name: deploy$`)),
+					resource.TestCheckResourceAttr("rundeck_job_definition.rendered", "name", "deploy"),
+					resource.TestCheckResourceAttr("rundeck_job_definition.rendered", "group", "ops"),
+				),
+			},
+			// The rendered document must round trip through Rundeck without drift
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+			},
+		},
+	})
+}
+
+const testAccJobDocumentDataSourceConfig = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-job-document"
+  description = "parent project for job document acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+data "rundeck_job_document" "test" {
+  name         = "deploy"
+  group_name   = "ops"
+  project_name = rundeck_project.test.name
+  description  = "Deploy the application"
+
+  command {
+    shell_command = "echo deploying"
+  }
+}
+
+resource "rundeck_job_definition" "rendered" {
+  project    = rundeck_project.test.name
+  definition = data.rundeck_job_document.test.yaml
+}
+`
@@ -0,0 +1,231 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &projectArchiveDataSource{}
+	_ datasource.DataSourceWithConfigure      = &projectArchiveDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &projectArchiveDataSource{}
+)
+
+// projectArchiveDefaultTimeout is how long an export may take by default.
+const projectArchiveDefaultTimeout = "30m"
+
+// NewProjectArchiveDataSource is a helper function to simplify the provider implementation.
+func NewProjectArchiveDataSource() datasource.DataSource {
+	return &projectArchiveDataSource{}
+}
+
+// projectArchiveDataSource is the data source implementation.
+type projectArchiveDataSource struct {
+	clients *RundeckClients
+}
+
+// projectArchiveDataSourceModel describes the data source data model.
+type projectArchiveDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Project              types.String `tfsdk:"project"`
+	OutputPath           types.String `tfsdk:"output_path"`
+	IncludeJobs          types.Bool   `tfsdk:"include_jobs"`
+	IncludeExecutions    types.Bool   `tfsdk:"include_executions"`
+	IncludeConfigs       types.Bool   `tfsdk:"include_configs"`
+	IncludeReadmes       types.Bool   `tfsdk:"include_readmes"`
+	IncludeACLs          types.Bool   `tfsdk:"include_acls"`
+	IncludeSCM           types.Bool   `tfsdk:"include_scm"`
+	IncludeWebhooks      types.Bool   `tfsdk:"include_webhooks"`
+	IncludeWebhookTokens types.Bool   `tfsdk:"include_webhook_tokens"`
+	ExecutionIDs         types.List   `tfsdk:"execution_ids"`
+	Async                types.Bool   `tfsdk:"async"`
+	Timeout              types.String `tfsdk:"timeout"`
+	SHA256               types.String `tfsdk:"sha256"`
+	Size                 types.Int64  `tfsdk:"size"`
+}
+
+// Metadata returns the data source type name.
+func (d *projectArchiveDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_archive"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectArchiveDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports a Rundeck project to a local archive file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the project.",
+				Computed:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project to export.",
+				Required:    true,
+			},
+			"output_path": schema.StringAttribute{
+				Description: "Path of the local file the archive is written to. Missing parent directories are created.",
+				Required:    true,
+			},
+			"include_jobs": schema.BoolAttribute{
+				Description: "Include jobs. Defaults to true.",
+				Optional:    true,
+			},
+			"include_executions": schema.BoolAttribute{
+				Description: "Include executions and their logs. Defaults to true.",
+				Optional:    true,
+			},
+			"include_configs": schema.BoolAttribute{
+				Description: "Include the project configuration. Defaults to true.",
+				Optional:    true,
+			},
+			"include_readmes": schema.BoolAttribute{
+				Description: "Include the project readme and motd files. Defaults to true.",
+				Optional:    true,
+			},
+			"include_acls": schema.BoolAttribute{
+				Description: "Include the project ACL policies. Defaults to true.",
+				Optional:    true,
+			},
+			"include_scm": schema.BoolAttribute{
+				Description: "Include the SCM configuration. Defaults to true.",
+				Optional:    true,
+			},
+			"include_webhooks": schema.BoolAttribute{
+				Description: "Include webhooks. Defaults to true.",
+				Optional:    true,
+			},
+			"include_webhook_tokens": schema.BoolAttribute{
+				Description: "Include webhook auth tokens; otherwise new tokens are generated on import. Defaults to false.",
+				Optional:    true,
+			},
+			"execution_ids": schema.ListAttribute{
+				Description: "Export only these executions. The archive then contains no jobs, ACL policies or configuration.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"async": schema.BoolAttribute{
+				Description: "Run the export in the background on the server and poll until it is ready. Defaults to true.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: "Maximum time to wait for the export, as a duration such as \"30m\". Defaults to \"30m\".",
+				Optional:    true,
+			},
+			"sha256": schema.StringAttribute{
+				Description: "SHA256 checksum of the archive.",
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "Size of the archive in bytes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *projectArchiveDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.clients = clients
+}
+
+// ValidateConfig checks that the timeout is a valid duration.
+func (d *projectArchiveDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var timeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validatePositiveDuration(path.Root("timeout"), timeout, &resp.Diagnostics)
+}
+
+// Read exports the project and writes the archive to the output path.
+func (d *projectArchiveDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config projectArchiveDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := config.Project.ValueString()
+	outputPath := config.OutputPath.ValueString()
+
+	query := url.Values{}
+	query.Set("exportAll", "false")
+	query.Set("exportJobs", strconv.FormatBool(boolValueOrDefault(config.IncludeJobs, true)))
+	query.Set("exportExecutions", strconv.FormatBool(boolValueOrDefault(config.IncludeExecutions, true)))
+	query.Set("exportConfigs", strconv.FormatBool(boolValueOrDefault(config.IncludeConfigs, true)))
+	query.Set("exportReadmes", strconv.FormatBool(boolValueOrDefault(config.IncludeReadmes, true)))
+	query.Set("exportAcls", strconv.FormatBool(boolValueOrDefault(config.IncludeACLs, true)))
+	query.Set("exportScm", strconv.FormatBool(boolValueOrDefault(config.IncludeSCM, true)))
+	query.Set("exportWebhooks", strconv.FormatBool(boolValueOrDefault(config.IncludeWebhooks, true)))
+	query.Set("whkIncludeAuthTokens", strconv.FormatBool(boolValueOrDefault(config.IncludeWebhookTokens, false)))
+	if !config.ExecutionIDs.IsNull() {
+		var executionIDs []string
+		resp.Diagnostics.Append(config.ExecutionIDs.ElementsAs(ctx, &executionIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		query.Set("executionIds", strings.Join(executionIDs, ","))
+	}
+
+	timeoutString := projectArchiveDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeoutString = config.Timeout.ValueString()
+	}
+	validatePositiveDuration(path.Root("timeout"), types.StringValue(timeoutString), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, _ := time.ParseDuration(timeoutString)
+
+	sum, size, err := exportProjectArchiveToFile(ctx, d.clients, project, query, boolValueOrDefault(config.Async, true), timeout, outputPath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error exporting project",
+			fmt.Sprintf("Could not export project %s to %s: %s", project, outputPath, err.Error()),
+		)
+		return
+	}
+
+	config.ID = types.StringValue(project)
+	config.SHA256 = types.StringValue(sum)
+	config.Size = types.Int64Value(size)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// boolValueOrDefault returns the value of an optional bool, or def if it is
+// not set.
+func boolValueOrDefault(v types.Bool, def bool) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+	return v.ValueBool()
+}
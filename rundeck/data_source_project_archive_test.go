@@ -0,0 +1,83 @@
+package rundeck
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectArchiveDataSource_basic(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "backups", "project.rdproject.jar")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectArchiveDataSourceConfig, outputPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rundeck_project_archive.test", "id", "terraform-acc-test-project-archive"),
+					resource.TestCheckResourceAttrSet("data.rundeck_project_archive.test", "size"),
+					testAccProjectArchiveDataSourceCheckFile("data.rundeck_project_archive.test", outputPath),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectArchiveDataSourceCheckFile(name, outputPath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("data source not found: %s", name)
+		}
+
+		sum, size, err := fileSHA256(outputPath)
+		if err != nil {
+			return fmt.Errorf("Error reading archive: %s", err)
+		}
+		if size == 0 {
+			return fmt.Errorf("archive %s is empty", outputPath)
+		}
+		if got := rs.Primary.Attributes["sha256"]; got != sum {
+			return fmt.Errorf("sha256: expected %s, got %s", sum, got)
+		}
+		if got := rs.Primary.Attributes["size"]; got != fmt.Sprint(size) {
+			return fmt.Errorf("size: expected %d, got %s", size, got)
+		}
+
+		return nil
+	}
+}
+
+const testAccProjectArchiveDataSourceConfig = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-project-archive"
+  description = "parent project for archive export acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_job" "test" {
+  project_name = rundeck_project.test.name
+  name         = "exported-job"
+  description  = "A job included in the project archive"
+
+  command {
+    shell_command = "echo Hello World"
+  }
+}
+
+data "rundeck_project_archive" "test" {
+  project            = rundeck_project.test.name
+  output_path        = "%s"
+  include_executions = false
+
+  depends_on = [rundeck_job.test]
+}
+`
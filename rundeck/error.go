@@ -1,7 +1,27 @@
 package rundeck
 
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
 type NotFoundError struct{}
 
 func (err NotFoundError) Error() string {
 	return "not found"
 }
+
+// openAPIErrorDetail appends the response body of a failed V2 API call to the
+// error message. The generated client only reports the HTTP status, while
+// Rundeck puts the actual reason (validation errors, conflicts) in the body.
+func openAPIErrorDetail(err error, httpResp *http.Response) string {
+	if httpResp == nil || httpResp.Body == nil {
+		return err.Error()
+	}
+	bodyBytes, _ := io.ReadAll(httpResp.Body)
+	if len(bodyBytes) == 0 {
+		return err.Error()
+	}
+	return fmt.Sprintf("%s - Response: %s", err.Error(), string(bodyBytes))
+}
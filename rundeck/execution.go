@@ -0,0 +1,195 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ExecutionResp is the execution document returned by the job run, ad-hoc run
+// and execution info endpoints.
+type ExecutionResp struct {
+	ID              int64          `json:"id"`
+	Href            string         `json:"href"`
+	Permalink       string         `json:"permalink"`
+	Status          string         `json:"status"`
+	Project         string         `json:"project"`
+	DateStarted     *ExecutionDate `json:"date-started"`
+	DateEnded       *ExecutionDate `json:"date-ended"`
+	SuccessfulNodes []string       `json:"successfulNodes"`
+	FailedNodes     []string       `json:"failedNodes"`
+}
+
+// ExecutionDate is a timestamp in an execution document.
+type ExecutionDate struct {
+	Unixtime int64  `json:"unixtime"`
+	Date     string `json:"date"`
+}
+
+// executionPollInterval is how often a running execution is polled.
+const executionPollInterval = 2 * time.Second
+
+// IsRunning reports whether the execution has not reached a final status yet.
+func (e *ExecutionResp) IsRunning() bool {
+	switch e.Status {
+	case "running", "scheduled", "queued":
+		return true
+	}
+	return false
+}
+
+// Duration returns the execution duration in milliseconds, or 0 if it has not
+// finished.
+func (e *ExecutionResp) Duration() int64 {
+	if e.DateStarted == nil || e.DateEnded == nil {
+		return 0
+	}
+	return e.DateEnded.Unixtime - e.DateStarted.Unixtime
+}
+
+// getExecution fetches the current state of an execution.
+func getExecution(ctx context.Context, clients *RundeckClients, id string) (*ExecutionResp, int, error) {
+	execution := &ExecutionResp{}
+	status, err := rundeckAPIRequest(ctx, clients, http.MethodGet, "execution/"+url.PathEscape(id), nil, nil, execution)
+	if err != nil {
+		return nil, status, err
+	}
+	return execution, status, nil
+}
+
+// waitForExecution polls an execution until it reaches a final status or the
+// timeout expires.
+func waitForExecution(ctx context.Context, clients *RundeckClients, id string, timeout time.Duration) (*ExecutionResp, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		execution, _, err := getExecution(ctx, clients, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("timed out after %s waiting for execution %s", timeout, id)
+			}
+			return nil, err
+		}
+		if !execution.IsRunning() {
+			return execution, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return execution, fmt.Errorf("timed out after %s waiting for execution %s (status: %s)", timeout, id, execution.Status)
+		case <-time.After(executionPollInterval):
+		}
+	}
+}
+
+// getExecutionLogTail returns the last lines of an execution's log output.
+func getExecutionLogTail(ctx context.Context, clients *RundeckClients, id string, lines int64) (string, error) {
+	if lines <= 0 {
+		return "", nil
+	}
+
+	var output struct {
+		Entries []struct {
+			Log string `json:"log"`
+		} `json:"entries"`
+	}
+	query := url.Values{}
+	query.Set("lastlines", fmt.Sprintf("%d", lines))
+	if _, err := rundeckAPIRequest(ctx, clients, http.MethodGet, "execution/"+url.PathEscape(id)+"/output", query, nil, &output); err != nil {
+		return "", err
+	}
+
+	logLines := make([]string, 0, len(output.Entries))
+	for _, entry := range output.Entries {
+		logLines = append(logLines, entry.Log)
+	}
+	return strings.Join(logLines, "\n"), nil
+}
+
+// executionResultModel holds the attributes describing the execution started
+// by rundeck_execution and rundeck_adhoc_command.
+type executionResultModel struct {
+	ID         types.String `tfsdk:"id"`
+	Status     types.String `tfsdk:"status"`
+	DurationMs types.Int64  `tfsdk:"duration_ms"`
+	LogTail    types.String `tfsdk:"log_tail"`
+	Permalink  types.String `tfsdk:"permalink"`
+}
+
+// validatePositiveDuration checks that a duration attribute, such as a
+// timeout, is a positive Go duration.
+func validatePositiveDuration(p path.Path, value types.String, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(value.ValueString()); err != nil || d <= 0 {
+		diags.AddAttributeError(
+			p,
+			"Invalid "+p.String(),
+			fmt.Sprintf("%s must be a positive duration such as \"30m\" or \"1h30m\", got: %s", p.String(), value.ValueString()),
+		)
+	}
+}
+
+// setExecutionResult copies the execution result into the model, including
+// the last logTailLines lines of output once the execution has finished.
+func setExecutionResult(ctx context.Context, clients *RundeckClients, model *executionResultModel, execution *ExecutionResp, logTailLines int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.Status = types.StringValue(execution.Status)
+	model.DurationMs = types.Int64Value(execution.Duration())
+	model.Permalink = types.StringValue(execution.Permalink)
+
+	logTail := ""
+	if !execution.IsRunning() {
+		var err error
+		logTail, err = getExecutionLogTail(ctx, clients, model.ID.ValueString(), logTailLines)
+		if err != nil {
+			diags.AddWarning(
+				"Could not read execution output",
+				fmt.Sprintf("Could not read the log output of execution %s: %s", model.ID.ValueString(), err.Error()),
+			)
+		}
+	}
+	model.LogTail = types.StringValue(logTail)
+
+	return diags
+}
+
+// readExecutionResult refreshes the model of an execution that was still
+// running; finished executions never change. It returns the execution, or nil
+// when nothing was refreshed.
+func readExecutionResult(ctx context.Context, clients *RundeckClients, model *executionResultModel, logTailLines int64) (*ExecutionResp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if model.Status.ValueString() != "" && !(&ExecutionResp{Status: model.Status.ValueString()}).IsRunning() {
+		return nil, diags
+	}
+
+	id := model.ID.ValueString()
+	execution, status, err := getExecution(ctx, clients, id)
+	if err != nil {
+		if status == 404 {
+			// The execution was removed from the history (e.g. by cleanup).
+			// Keep the state so it does not run again.
+			return nil, diags
+		}
+		diags.AddError(
+			"Error reading execution",
+			fmt.Sprintf("Could not read execution %s: %s", id, err.Error()),
+		)
+		return nil, diags
+	}
+
+	diags.Append(setExecutionResult(ctx, clients, model, execution, logTailLines)...)
+	return execution, diags
+}
@@ -0,0 +1,117 @@
+package rundeck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWaitForExecution(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Rundeck-Auth-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/56/execution/42":
+			_, _ = w.Write([]byte(`{"id": 42, "status": "failed", "permalink": "http://rundeck/execution/42",
+				"date-started": {"unixtime": 1000}, "date-ended": {"unixtime": 3500},
+				"successfulNodes": ["node1"], "failedNodes": ["node2"]}`))
+		case "/api/56/execution/42/output":
+			if got := r.URL.Query().Get("lastlines"); got != "2" {
+				t.Errorf("expected lastlines=2, got %q", got)
+			}
+			_, _ = w.Write([]byte(`{"entries": [{"log": "line one"}, {"log": "line two"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+	ctx := context.Background()
+
+	execution, err := waitForExecution(ctx, clients, "42", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if execution.IsRunning() || execution.Status != "failed" {
+		t.Errorf("expected finished failed execution, got %q", execution.Status)
+	}
+	if d := execution.Duration(); d != 2500 {
+		t.Errorf("expected duration 2500, got %d", d)
+	}
+	if len(execution.FailedNodes) != 1 || execution.FailedNodes[0] != "node2" {
+		t.Errorf("unexpected failed nodes: %v", execution.FailedNodes)
+	}
+
+	logTail, err := getExecutionLogTail(ctx, clients, "42", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logTail != "line one\nline two" {
+		t.Errorf("unexpected log tail: %q", logTail)
+	}
+
+	if _, status, err := getExecution(ctx, clients, "43"); err == nil || status != http.StatusNotFound {
+		t.Errorf("expected 404 for unknown execution, got status %d, err %v", status, err)
+	}
+}
+
+func TestReadExecutionResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/56/execution/42":
+			_, _ = w.Write([]byte(`{"id": 42, "status": "succeeded", "permalink": "http://rundeck/execution/42",
+				"date-started": {"unixtime": 1000}, "date-ended": {"unixtime": 2000}}`))
+		case "/api/56/execution/42/output":
+			_, _ = w.Write([]byte(`{"entries": [{"log": "done"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+	ctx := context.Background()
+
+	model := executionResultModel{ID: types.StringValue("42"), Status: types.StringValue("running")}
+	execution, diags := readExecutionResult(ctx, clients, &model, 5)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if execution == nil || model.Status.ValueString() != "succeeded" || model.DurationMs.ValueInt64() != 1000 || model.LogTail.ValueString() != "done" {
+		t.Errorf("unexpected result: %+v", model)
+	}
+
+	// Finished executions are not read again
+	execution, diags = readExecutionResult(ctx, clients, &model, 5)
+	if execution != nil || diags.HasError() {
+		t.Errorf("expected a finished execution to be left alone, got %v, %v", execution, diags)
+	}
+
+	// An execution removed from the history keeps its state
+	model = executionResultModel{ID: types.StringValue("43"), Status: types.StringValue("running")}
+	execution, diags = readExecutionResult(ctx, clients, &model, 5)
+	if execution != nil || diags.HasError() || model.Status.ValueString() != "running" {
+		t.Errorf("expected a removed execution to keep its state, got %+v, %v", model, diags)
+	}
+}
+
+func TestValidatePositiveDuration(t *testing.T) {
+	for timeout, valid := range map[string]bool{"30m": true, "1h30m": true, "0s": false, "-5m": false, "soon": false} {
+		var diags diag.Diagnostics
+		validatePositiveDuration(path.Root("timeout"), types.StringValue(timeout), &diags)
+		if diags.HasError() == valid {
+			t.Errorf("timeout %q: expected valid=%v, got %v", timeout, valid, diags)
+		}
+	}
+}
@@ -23,10 +23,13 @@
 package rundeck
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/rundeck/go-rundeck/rundeck"
 	"github.com/rundeck/go-rundeck/rundeck/auth"
@@ -147,3 +150,213 @@ func GetJobJSON(c *rundeck.BaseClient, id string) (*JobJSON, error) {
 
 	return &jobs[0], nil
 }
+
+// jobListEntry is an entry of the project job list.
+type jobListEntry struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Group   string `json:"group"`
+	Project string `json:"project"`
+}
+
+// findJobByName returns the ID of the job with exactly the given group and
+// name in a project, or "" if there is none. An empty group matches only
+// top-level jobs. More than one match is an error, since it is ambiguous which
+// job is meant.
+func findJobByName(ctx context.Context, clients *RundeckClients, project, group, name string) (string, error) {
+	query := url.Values{}
+	query.Set("jobExactFilter", name)
+	if group == "" {
+		query.Set("groupPathExact", "-")
+	} else {
+		query.Set("groupPathExact", group)
+	}
+
+	var jobs []jobListEntry
+	apiPath := fmt.Sprintf("project/%s/jobs", url.PathEscape(project))
+	if _, err := rundeckAPIRequest(ctx, clients, http.MethodGet, apiPath, query, nil, &jobs); err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, job := range jobs {
+		if job.Name == name && job.Group == group {
+			ids = append(ids, job.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", nil
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d jobs named %q in group %q of project %s: %s", len(ids), name, group, project, strings.Join(ids, ", "))
+	}
+}
+
+// jobFieldSet describes which fields of a job definition rundeck_job manages.
+// A nil entry means the whole field is managed; otherwise only the listed
+// nested fields are, and the rest of the object is kept as found on the server.
+type jobFieldSet map[string]jobFieldSet
+
+// jobManagedFields are the job definition fields written by planToJobJSON.
+// Fields missing from the planned document are removed, since that is how the
+// resource expresses an unset attribute.
+var jobManagedFields = jobFieldSet{
+	"id":                     nil,
+	"name":                   nil,
+	"group":                  nil,
+	"project":                nil,
+	"description":            nil,
+	"executionEnabled":       nil,
+	"defaultTab":             nil,
+	"loglevel":               nil,
+	"loglimit":               nil,
+	"loglimitAction":         nil,
+	"loglimitStatus":         nil,
+	"multipleExecutions":     nil,
+	"maxMultipleExecutions":  nil,
+	"notification":           nil,
+	"timeout":                nil,
+	"retry":                  nil,
+	"nodeFilterEditable":     nil,
+	"nodefilters":            nil,
+	"dispatch":               nil,
+	"options":                nil,
+	"nodesSelectedByDefault": nil,
+	"schedule":               nil,
+	"schedules":              nil,
+	"scheduleEnabled":        nil,
+	"timeZone":               nil,
+	"orchestrator":           nil,
+	"runnerSelector":         nil,
+	"sequence": {
+		"keepgoing": nil,
+		"strategy":  nil,
+		"commands":  nil,
+		"pluginConfig": {
+			"LogFilter": nil,
+		},
+	},
+	"plugins": {
+		"ExecutionLifecycle": nil,
+	},
+}
+
+// mergeJobDefinition overlays the managed fields of planned on the current
+// job definition. Fields outside fields are kept from current, so settings the
+// resource does not model (job plugins, newer Rundeck options) survive an
+// update. Nested objects left empty by the merge are dropped.
+func mergeJobDefinition(current, planned map[string]interface{}, fields jobFieldSet) map[string]interface{} {
+	merged := make(map[string]interface{}, len(current)+len(planned))
+	for k, v := range current {
+		merged[k] = v
+	}
+	for k, v := range planned {
+		if _, ok := fields[k]; !ok {
+			merged[k] = v
+		}
+	}
+
+	for key, nested := range fields {
+		plannedValue, inPlan := planned[key]
+		if nested == nil {
+			if inPlan {
+				merged[key] = plannedValue
+			} else {
+				delete(merged, key)
+			}
+			continue
+		}
+
+		currentObj, _ := current[key].(map[string]interface{})
+		plannedObj, _ := plannedValue.(map[string]interface{})
+		obj := mergeJobDefinition(currentObj, plannedObj, nested)
+		if len(obj) == 0 {
+			delete(merged, key)
+		} else {
+			merged[key] = obj
+		}
+	}
+	return merged
+}
+
+// jobFormatAPIVersion is the lowest API version supporting JSON job import
+// and export (Rundeck 5.0.0+).
+const jobFormatAPIVersion = "46"
+
+// withJobFormatAPIVersion returns clients that use at least
+// jobFormatAPIVersion, like rundeck_job does for its imports.
+func withJobFormatAPIVersion(clients *RundeckClients) *RundeckClients {
+	if clients.APIVersion >= jobFormatAPIVersion {
+		return clients
+	}
+	raised := *clients
+	raised.APIVersion = jobFormatAPIVersion
+	return &raised
+}
+
+// getJobDefinition returns the full definition of a job as a generic document,
+// keeping fields that JobJSON does not model.
+func getJobDefinition(ctx context.Context, clients *RundeckClients, id string) (map[string]interface{}, error) {
+	var jobs []map[string]interface{}
+	status, err := rundeckAPIRequest(ctx, withJobFormatAPIVersion(clients), http.MethodGet, "job/"+url.PathEscape(id), nil, nil, &jobs)
+	if status == 404 {
+		return nil, &NotFoundError{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, &NotFoundError{}
+	}
+	return jobs[0], nil
+}
+
+// jobUpdateDocument builds the import document for updating job id from the
+// planned definition, merged over the job's current definition. If the job no
+// longer exists the planned definition is used as is.
+func jobUpdateDocument(ctx context.Context, clients *RundeckClients, id string, job interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	var planned map[string]interface{}
+	if err := json.Unmarshal(raw, &planned); err != nil {
+		return nil, err
+	}
+
+	current, err := getJobDefinition(ctx, clients, id)
+	if err != nil {
+		if _, ok := err.(*NotFoundError); ok {
+			return planned, nil
+		}
+		return nil, fmt.Errorf("could not read current job definition: %w", err)
+	}
+	return mergeJobDefinition(current, planned, jobManagedFields), nil
+}
+
+// jobCreateDocument builds the import document for creating a job. When adopt
+// is set and a job with the same name and group already exists, the document
+// updates that job instead, merged over its current definition like an
+// update, and the ID of the adopted job is returned.
+func jobCreateDocument(ctx context.Context, clients *RundeckClients, job *jobJSON, adopt bool) (interface{}, string, error) {
+	if !adopt {
+		return job, "", nil
+	}
+
+	existingID, err := findJobByName(ctx, clients, job.Project, job.Group, job.Name)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not look up existing job to adopt: %w", err)
+	}
+	if existingID == "" {
+		return job, "", nil
+	}
+
+	job.ID = existingID
+	document, err := jobUpdateDocument(ctx, clients, existingID, job)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not merge job with the definition of the adopted job: %w", err)
+	}
+	return document, existingID, nil
+}
@@ -0,0 +1,212 @@
+package rundeck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Raw job definitions are documents in Rundeck's job format, as produced by
+// the GUI's "download definition" or GET /job/<id>. They are accepted as YAML
+// or JSON and always sent to Rundeck as JSON.
+
+// jobDefinitionServerFields are set by Rundeck and not compared when checking
+// a definition for drift.
+var jobDefinitionServerFields = []string{"id", "uuid", "href", "permalink", "project"}
+
+// jobDefinitionDefaults are values Rundeck assumes for fields that are left
+// out, and that it may or may not write back depending on version. Missing
+// fields (dotted paths) are filled in on both sides before comparing.
+var jobDefinitionDefaults = map[string]string{
+	"executionEnabled":                              "true",
+	"scheduleEnabled":                               "true",
+	"multipleExecutions":                            "false",
+	"nodeFilterEditable":                            "false",
+	"nodesSelectedByDefault":                        "true",
+	"loglevel":                                      "INFO",
+	"defaultTab":                                    "nodes",
+	"sequence.keepgoing":                            "false",
+	"sequence.strategy":                             "node-first",
+	"nodefilters.dispatch.threadcount":              "1",
+	"nodefilters.dispatch.keepgoing":                "false",
+	"nodefilters.dispatch.excludePrecedence":        "true",
+	"nodefilters.dispatch.rankOrder":                "ascending",
+	"nodefilters.dispatch.successOnEmptyNodeFilter": "false",
+}
+
+// parseJobDefinition parses a YAML or JSON job definition. The document may
+// be a single job or a list holding exactly one job.
+func parseJobDefinition(definition string) (map[string]interface{}, error) {
+	var doc interface{}
+	if jobDefinitionFormat(definition) == "json" {
+		if err := json.Unmarshal([]byte(definition), &doc); err != nil {
+			return nil, fmt.Errorf("definition is not valid JSON: %w", err)
+		}
+	} else if err := yaml.Unmarshal([]byte(definition), &doc); err != nil {
+		return nil, fmt.Errorf("definition is not valid YAML: %w", err)
+	}
+
+	if list, ok := doc.([]interface{}); ok {
+		if len(list) != 1 {
+			return nil, fmt.Errorf("definition must contain exactly one job, found %d", len(list))
+		}
+		doc = list[0]
+	}
+	job, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("definition must be a job or a list with one job")
+	}
+	if name, _ := job["name"].(string); name == "" {
+		return nil, fmt.Errorf("job definition has no name")
+	}
+	return job, nil
+}
+
+// jobDefinitionFormat returns "json" when the definition is a JSON document
+// and "yaml" otherwise.
+func jobDefinitionFormat(definition string) string {
+	trimmed := strings.TrimSpace(definition)
+	if strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{") {
+		return "json"
+	}
+	return "yaml"
+}
+
+// renderJobDefinition renders a job as a one-job list, the layout Rundeck uses
+// for downloaded definitions.
+func renderJobDefinition(job map[string]interface{}, format string) (string, error) {
+	if format == "json" {
+		out, err := json.MarshalIndent([]interface{}{job}, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(out) + "\n", nil
+	}
+	out, err := yaml.Marshal([]interface{}{job})
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// normalizeJobDefinition returns a copy of the job for comparison. Scalars are
+// compared as strings, since Rundeck returns most numbers and booleans as
+// strings, empty values and server fields are dropped, and missing fields that
+// have a default are filled in.
+func normalizeJobDefinition(job map[string]interface{}) map[string]interface{} {
+	normalized, _ := normalizeJobDefinitionValue(job)
+	result, _ := normalized.(map[string]interface{})
+	if result == nil {
+		result = map[string]interface{}{}
+	}
+
+	for _, field := range jobDefinitionServerFields {
+		delete(result, field)
+	}
+	for field, def := range jobDefinitionDefaults {
+		parts := strings.Split(field, ".")
+		parent := result
+		for _, part := range parts[:len(parts)-1] {
+			if parent[part] == nil {
+				parent[part] = map[string]interface{}{}
+			}
+			parent, _ = parent[part].(map[string]interface{})
+			if parent == nil {
+				break
+			}
+		}
+		if _, ok := parent[parts[len(parts)-1]]; parent != nil && !ok {
+			parent[parts[len(parts)-1]] = def
+		}
+	}
+	return result
+}
+
+// normalizeJobDefinitionValue normalizes a value and reports whether it is
+// non-empty.
+func normalizeJobDefinitionValue(v interface{}) (interface{}, bool) {
+	switch value := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return value, value != ""
+	case map[string]interface{}:
+		normalized := map[string]interface{}{}
+		for k, item := range value {
+			if n, ok := normalizeJobDefinitionValue(item); ok {
+				normalized[k] = n
+			}
+		}
+		return normalized, len(normalized) > 0
+	case []interface{}:
+		// Empty elements are kept so that list positions still line up
+		normalized := make([]interface{}, len(value))
+		for i, item := range value {
+			normalized[i], _ = normalizeJobDefinitionValue(item)
+		}
+		return normalized, len(normalized) > 0
+	default:
+		return fmt.Sprint(value), true
+	}
+}
+
+// jobDefinitionMatches reports whether the job read from Rundeck still
+// matches the configured definition. Both are normalized first, so formatting,
+// server fields and defaults filled in by Rundeck are ignored, while any other
+// field added or changed on either side is a difference.
+func jobDefinitionMatches(configured, current map[string]interface{}) bool {
+	return reflect.DeepEqual(normalizeJobDefinition(configured), normalizeJobDefinition(current))
+}
+
+// jobImportResult is the response of the job import endpoint.
+type jobImportResult struct {
+	Succeeded []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"succeeded"`
+	Failed []struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	} `json:"failed"`
+	Skipped []struct {
+		Error string `json:"error"`
+	} `json:"skipped"`
+}
+
+// importJobDefinition imports a single job into a project and returns its ID.
+// The job's UUID is preserved. dupeOption is Rundeck's duplicate handling:
+// "create" never touches an existing job, while "update" replaces the job with
+// the same UUID (or name and group) in place.
+func importJobDefinition(ctx context.Context, clients *RundeckClients, project string, job map[string]interface{}, dupeOption string) (string, error) {
+	query := url.Values{}
+	query.Set("fileformat", "json")
+	query.Set("dupeOption", dupeOption)
+	query.Set("uuidOption", "preserve")
+
+	var result jobImportResult
+	apiPath := fmt.Sprintf("project/%s/jobs/import", url.PathEscape(project))
+	if _, err := rundeckAPIRequest(ctx, withJobFormatAPIVersion(clients), http.MethodPost, apiPath, query, []interface{}{job}, &result); err != nil {
+		return "", err
+	}
+
+	if len(result.Failed) > 0 {
+		msg := result.Failed[0].Error
+		if msg == "" {
+			msg = result.Failed[0].Message
+		}
+		return "", fmt.Errorf("job import failed: %s", msg)
+	}
+	if len(result.Skipped) > 0 {
+		return "", fmt.Errorf("job import was skipped: %s", result.Skipped[0].Error)
+	}
+	if len(result.Succeeded) == 0 || result.Succeeded[0].ID == "" {
+		return "", fmt.Errorf("job import returned no job ID")
+	}
+	return result.Succeeded[0].ID, nil
+}
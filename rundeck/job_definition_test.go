@@ -0,0 +1,180 @@
+package rundeck
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testJobDefinitionYAML = `
+- name: deploy
+  group: ops
+  description: Deploy the application
+  nodefilters:
+    dispatch:
+      threadcount: 2
+      keepgoing: false
+    filter: "tags: web"
+  sequence:
+    commands:
+    - exec: ./deploy.sh
+    - description: ""
+      script: echo done
+`
+
+// testJobDefinitionServer is testJobDefinitionYAML as Rundeck returns it.
+const testJobDefinitionServer = `[{
+	"id": "aaa", "uuid": "aaa", "href": "http://rundeck/api/56/job/aaa",
+	"name": "deploy", "group": "ops", "description": "Deploy the application",
+	"executionEnabled": true, "scheduleEnabled": true, "loglevel": "INFO",
+	"nodeFilterEditable": false, "nodesSelectedByDefault": true,
+	"notifyAvgDurationThreshold": null,
+	"nodefilters": {"dispatch": {"threadcount": "2", "keepgoing": false, "excludePrecedence": true}, "filter": "tags: web"},
+	"sequence": {"keepgoing": false, "strategy": "node-first",
+		"commands": [{"exec": "./deploy.sh"}, {"script": "echo done"}]}
+}]`
+
+func TestParseJobDefinition(t *testing.T) {
+	job, err := parseJobDefinition(testJobDefinitionYAML)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job["name"] != "deploy" || job["group"] != "ops" {
+		t.Errorf("unexpected job: %v", job)
+	}
+
+	job, err = parseJobDefinition("{\n\t\"name\": \"from-json\"\n}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job["name"] != "from-json" {
+		t.Errorf("unexpected job: %v", job)
+	}
+
+	for _, definition := range []string{
+		"- name: one\n- name: two\n",
+		"[]",
+		"name: [unclosed",
+		"description: no name\n",
+		"just a string",
+	} {
+		if _, err := parseJobDefinition(definition); err == nil {
+			t.Errorf("expected an error for definition %q", definition)
+		}
+	}
+}
+
+func TestJobDefinitionMatches(t *testing.T) {
+	configured, err := parseJobDefinition(testJobDefinitionYAML)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var server []map[string]interface{}
+	if err := json.Unmarshal([]byte(testJobDefinitionServer), &server); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	current := server[0]
+
+	if !jobDefinitionMatches(configured, current) {
+		t.Error("expected the stored job to match its definition")
+	}
+
+	current["executionEnabled"] = false
+	if jobDefinitionMatches(configured, current) {
+		t.Error("expected a disabled job to differ from a definition relying on the default")
+	}
+	current["executionEnabled"] = true
+
+	current["notification"] = map[string]interface{}{"onfailure": map[string]interface{}{"email": map[string]interface{}{"recipients": "ops@example.com"}}}
+	if jobDefinitionMatches(configured, current) {
+		t.Error("expected a notification added on the server to be detected")
+	}
+	delete(current, "notification")
+
+	current["sequence"].(map[string]interface{})["commands"] = []interface{}{
+		map[string]interface{}{"exec": "./deploy.sh"},
+	}
+	if jobDefinitionMatches(configured, current) {
+		t.Error("expected a removed step to be detected")
+	}
+}
+
+func TestRenderJobDefinition(t *testing.T) {
+	job := map[string]interface{}{"name": "deploy", "sequence": map[string]interface{}{"commands": []interface{}{}}}
+
+	for _, format := range []string{"yaml", "json"} {
+		definition, err := renderJobDefinition(job, format)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := jobDefinitionFormat(definition); got != format {
+			t.Errorf("expected %s output, detected %s:\n%s", format, got, definition)
+		}
+		parsed, err := parseJobDefinition(definition)
+		if err != nil {
+			t.Fatalf("rendered %s does not parse: %v", format, err)
+		}
+		if parsed["name"] != "deploy" {
+			t.Errorf("unexpected round trip result: %v", parsed)
+		}
+	}
+}
+
+func TestImportJobDefinition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/56/project/test-project/jobs/import" && r.URL.Path != "/api/46/project/test-project/jobs/import" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query := r.URL.Query()
+		if query.Get("fileformat") != "json" || query.Get("uuidOption") != "preserve" {
+			t.Errorf("unexpected import options: %s", r.URL.RawQuery)
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("dupeOption") == "create" && strings.Contains(string(body), `"existing"`) {
+			_, _ = w.Write([]byte(`{"succeeded": [], "failed": [{"index": 0, "error": "A job with UUID bbb already exists"}], "skipped": []}`))
+			return
+		}
+		if strings.Contains(string(body), `"broken"`) {
+			_, _ = w.Write([]byte(`{"succeeded": [], "failed": [{"index": 0, "error": "Workflow must have at least one step"}], "skipped": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"succeeded": [{"index": 0, "id": "aaa", "name": "deploy"}], "failed": [], "skipped": []}`))
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+	ctx := context.Background()
+
+	id, err := importJobDefinition(ctx, clients, "test-project", map[string]interface{}{"name": "deploy"}, "create")
+	if err != nil || id != "aaa" {
+		t.Errorf("expected job aaa, got %q, err %v", id, err)
+	}
+
+	// Creating must not take over an existing job
+	_, err = importJobDefinition(ctx, clients, "test-project", map[string]interface{}{"name": "existing"}, "create")
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected creating over an existing job to fail, got %v", err)
+	}
+
+	id, err = importJobDefinition(ctx, clients, "test-project", map[string]interface{}{"name": "existing"}, "update")
+	if err != nil || id != "aaa" {
+		t.Errorf("expected job aaa, got %q, err %v", id, err)
+	}
+
+	// JSON import needs API v46, whatever the provider is configured with
+	oldClients := &RundeckClients{BaseURL: server.URL, APIVersion: "14", Token: "test-token"}
+	if _, err := importJobDefinition(ctx, oldClients, "test-project", map[string]interface{}{"name": "deploy"}, "create"); err != nil {
+		t.Errorf("expected the import to use API v46, got %v", err)
+	}
+
+	_, err = importJobDefinition(ctx, clients, "test-project", map[string]interface{}{"name": "broken"}, "update")
+	if err == nil || !strings.Contains(err.Error(), "at least one step") {
+		t.Errorf("expected the import failure to be reported, got %v", err)
+	}
+}
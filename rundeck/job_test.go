@@ -0,0 +1,208 @@
+package rundeck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestFindJobByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/56/project/test-project/jobs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		query := r.URL.Query()
+		switch query.Get("jobExactFilter") {
+		case "deploy":
+			if got := query.Get("groupPathExact"); got != "ops/release" {
+				t.Errorf("expected groupPathExact=ops/release, got %q", got)
+			}
+			_, _ = w.Write([]byte(`[
+				{"id": "aaa", "name": "deploy", "group": "ops/release"},
+				{"id": "bbb", "name": "deploy-all", "group": "ops/release"}
+			]`))
+		case "top":
+			if got := query.Get("groupPathExact"); got != "-" {
+				t.Errorf("expected groupPathExact=- for top level job, got %q", got)
+			}
+			_, _ = w.Write([]byte(`[{"id": "ccc", "name": "top", "group": ""}]`))
+		case "twice":
+			_, _ = w.Write([]byte(`[
+				{"id": "ddd", "name": "twice", "group": ""},
+				{"id": "eee", "name": "twice", "group": ""}
+			]`))
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+	ctx := context.Background()
+
+	id, err := findJobByName(ctx, clients, "test-project", "ops/release", "deploy")
+	if err != nil || id != "aaa" {
+		t.Errorf("expected job aaa, got %q, err %v", id, err)
+	}
+
+	id, err = findJobByName(ctx, clients, "test-project", "", "top")
+	if err != nil || id != "ccc" {
+		t.Errorf("expected job ccc, got %q, err %v", id, err)
+	}
+
+	id, err = findJobByName(ctx, clients, "test-project", "", "missing")
+	if err != nil || id != "" {
+		t.Errorf("expected no job, got %q, err %v", id, err)
+	}
+
+	if _, err := findJobByName(ctx, clients, "test-project", "", "twice"); err == nil {
+		t.Error("expected an error when the name matches more than one job")
+	}
+}
+
+func TestMergeJobDefinition(t *testing.T) {
+	current := map[string]interface{}{
+		"id":                         "aaa",
+		"name":                       "old name",
+		"notifyAvgDurationThreshold": "30s",
+		"timeout":                    "1h",
+		"schedule":                   map[string]interface{}{"time": map[string]interface{}{"hour": "1"}},
+		"sequence": map[string]interface{}{
+			"strategy":  "node-first",
+			"commands":  []interface{}{map[string]interface{}{"exec": "old"}},
+			"keepgoing": true,
+			"pluginConfig": map[string]interface{}{
+				"LogFilter":        []interface{}{map[string]interface{}{"type": "mask-passwords"}},
+				"WorkflowStrategy": map[string]interface{}{"node-first": map[string]interface{}{}},
+			},
+		},
+		"plugins": map[string]interface{}{
+			"ExecutionLifecycle": map[string]interface{}{"killhandler": map[string]interface{}{}},
+			"JobPlugin":          map[string]interface{}{"enabled": "true"},
+		},
+	}
+	planned := map[string]interface{}{
+		"id":   "aaa",
+		"name": "new name",
+		"sequence": map[string]interface{}{
+			"strategy": "node-first",
+			"commands": []interface{}{map[string]interface{}{"exec": "new"}},
+		},
+	}
+
+	got := mergeJobDefinition(current, planned, jobManagedFields)
+	expected := map[string]interface{}{
+		"id":                         "aaa",
+		"name":                       "new name",
+		"notifyAvgDurationThreshold": "30s",
+		"sequence": map[string]interface{}{
+			"strategy": "node-first",
+			"commands": []interface{}{map[string]interface{}{"exec": "new"}},
+			"pluginConfig": map[string]interface{}{
+				"WorkflowStrategy": map[string]interface{}{"node-first": map[string]interface{}{}},
+			},
+		},
+		"plugins": map[string]interface{}{
+			"JobPlugin": map[string]interface{}{"enabled": "true"},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected merge result:\n got: %#v\nwant: %#v", got, expected)
+	}
+
+	// The current definition must not be modified
+	if current["name"] != "old name" || current["timeout"] != "1h" {
+		t.Errorf("current definition was modified: %#v", current)
+	}
+}
+
+func TestJobUpdateDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/56/job/aaa":
+			_, _ = w.Write([]byte(`[{"id": "aaa", "name": "deploy", "project": "test-project",
+				"plugins": {"JobPlugin": {"enabled": "true"}}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+	ctx := context.Background()
+	job := &jobJSON{ID: "aaa", Name: "deploy", Project: "test-project", Description: "Deploys"}
+
+	document, err := jobUpdateDocument(ctx, clients, "aaa", job)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if document["description"] != "Deploys" {
+		t.Errorf("expected planned description, got %v", document["description"])
+	}
+	if _, ok := document["plugins"].(map[string]interface{})["JobPlugin"]; !ok {
+		t.Errorf("expected unmanaged job plugin to be kept, got %v", document["plugins"])
+	}
+
+	// A job deleted outside Terraform is recreated from the plan alone
+	document, err = jobUpdateDocument(ctx, clients, "bbb", job)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := document["plugins"]; ok {
+		t.Errorf("expected no plugins for a missing job, got %v", document["plugins"])
+	}
+}
+
+func TestJobCreateDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/56/project/test-project/jobs":
+			if r.URL.Query().Get("jobExactFilter") == "deploy" {
+				_, _ = w.Write([]byte(`[{"id": "aaa", "name": "deploy", "group": ""}]`))
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		case "/api/56/job/aaa":
+			_, _ = w.Write([]byte(`[{"id": "aaa", "name": "deploy", "project": "test-project",
+				"plugins": {"JobPlugin": {"enabled": "true"}}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+	ctx := context.Background()
+
+	// An adopted job keeps the plugins this resource does not manage
+	job := &jobJSON{Name: "deploy", Project: "test-project", Description: "Deploys"}
+	document, id, err := jobCreateDocument(ctx, clients, job, true)
+	if err != nil || id != "aaa" {
+		t.Fatalf("expected to adopt job aaa, got %q, err %v", id, err)
+	}
+	merged, ok := document.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a merged document, got %T", document)
+	}
+	if merged["id"] != "aaa" || merged["description"] != "Deploys" {
+		t.Errorf("unexpected adopted document: %v", merged)
+	}
+	if _, ok := merged["plugins"].(map[string]interface{})["JobPlugin"]; !ok {
+		t.Errorf("expected unmanaged job plugin to be kept, got %v", merged["plugins"])
+	}
+
+	// Without a matching job, or without adopt_existing, the plan is used as is
+	for _, adopt := range []bool{true, false} {
+		job := &jobJSON{Name: "new", Project: "test-project"}
+		document, id, err := jobCreateDocument(ctx, clients, job, adopt)
+		if err != nil || id != "" || document != job {
+			t.Errorf("adopt=%v: expected the planned job, got %v, %q, err %v", adopt, document, id, err)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package rundeck
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// projectExportPollInterval is how often an asynchronous export is polled.
+const projectExportPollInterval = 2 * time.Second
+
+// projectExportStatus is the status of an asynchronous project export.
+type projectExportStatus struct {
+	Token      string `json:"token"`
+	Ready      bool   `json:"ready"`
+	Percentage int64  `json:"percentage"`
+}
+
+// projectImportResult is the response of the project archive import endpoint.
+// import_status is "failed" only when jobs failed to import; problems with
+// the other parts of the archive are reported in their own error lists.
+type projectImportResult struct {
+	ImportStatus    string   `json:"import_status"`
+	Errors          []string `json:"errors"`
+	ExecutionErrors []string `json:"execution_errors"`
+	AclErrors       []string `json:"acl_errors"`
+	OtherErrors     []string `json:"other_errors"`
+}
+
+// projectImportErrorCategory groups the import errors of one part of the
+// archive.
+type projectImportErrorCategory struct {
+	Name   string
+	Errors []string
+}
+
+// errorCategories returns the non-empty error lists of an import, in a fixed
+// order.
+func (r *projectImportResult) errorCategories() []projectImportErrorCategory {
+	var categories []projectImportErrorCategory
+	for _, category := range []projectImportErrorCategory{
+		{Name: "Job", Errors: r.Errors},
+		{Name: "Execution", Errors: r.ExecutionErrors},
+		{Name: "ACL policy", Errors: r.AclErrors},
+		{Name: "Other", Errors: r.OtherErrors},
+	} {
+		if len(category.Errors) > 0 {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// fileSHA256 returns the hex encoded SHA256 checksum and size of a file.
+func fileSHA256(filePath string) (string, int64, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not read %s: %w", filePath, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// exportProjectArchive exports a project and streams the archive to w. With
+// async the export runs in the background on the server and is polled until it
+// is ready, so large projects don't hit HTTP timeouts; otherwise the archive is
+// returned by a single request. The number of bytes written is returned.
+func exportProjectArchive(ctx context.Context, clients *RundeckClients, project string, query url.Values, async bool, timeout time.Duration, w io.Writer) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	projectPath := "project/" + url.PathEscape(project)
+	if !async {
+		return rundeckAPIDownload(ctx, clients, projectPath+"/export", query, "application/zip", w)
+	}
+
+	status := &projectExportStatus{}
+	if _, err := rundeckAPIRequest(ctx, clients, http.MethodGet, projectPath+"/export/async", query, nil, status); err != nil {
+		return 0, fmt.Errorf("could not start export: %w", err)
+	}
+	token := status.Token
+	if token == "" {
+		return 0, fmt.Errorf("export of project %s did not return a token", project)
+	}
+
+	for {
+		status = &projectExportStatus{}
+		if _, err := rundeckAPIRequest(ctx, clients, http.MethodGet, projectPath+"/export/status/"+url.PathEscape(token), nil, nil, status); err != nil {
+			if ctx.Err() != nil {
+				return 0, fmt.Errorf("timed out after %s waiting for export of project %s", timeout, project)
+			}
+			return 0, fmt.Errorf("could not read export status: %w", err)
+		}
+		if status.Ready {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("timed out after %s waiting for export of project %s (%d%% done)", timeout, project, status.Percentage)
+		case <-time.After(projectExportPollInterval):
+		}
+	}
+
+	return rundeckAPIDownload(ctx, clients, projectPath+"/export/download/"+url.PathEscape(token), nil, "application/zip", w)
+}
+
+// exportProjectArchiveToFile exports a project to outputPath and returns the
+// archive's SHA256 and size. The archive is written to a temporary file next
+// to the output first, so a failed export never leaves a partial archive at
+// outputPath.
+func exportProjectArchiveToFile(ctx context.Context, clients *RundeckClients, project string, query url.Values, async bool, timeout time.Duration, outputPath string) (string, int64, error) {
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return "", 0, fmt.Errorf("could not create directory for %s: %w", outputPath, err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".*")
+	if err != nil {
+		return "", 0, fmt.Errorf("could not create %s: %w", outputPath, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	size, err := exportProjectArchive(ctx, clients, project, query, async, timeout, io.MultiWriter(tmp, hash))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	if err := os.Rename(tmp.Name(), outputPath); err != nil {
+		return "", 0, fmt.Errorf("could not write %s: %w", outputPath, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
@@ -0,0 +1,107 @@
+package rundeck
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProjectImportResult_errorCategories(t *testing.T) {
+	result := projectImportResult{
+		ImportStatus: "failed",
+		Errors:       []string{"Job ABC could not be validated"},
+		AclErrors:    []string{"a.aclpolicy could not be validated", "b.aclpolicy could not be validated"},
+	}
+
+	expected := []projectImportErrorCategory{
+		{Name: "Job", Errors: []string{"Job ABC could not be validated"}},
+		{Name: "ACL policy", Errors: []string{"a.aclpolicy could not be validated", "b.aclpolicy could not be validated"}},
+	}
+	if got := result.errorCategories(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if got := (&projectImportResult{ImportStatus: "successful"}).errorCategories(); len(got) != 0 {
+		t.Errorf("expected no error categories, got %v", got)
+	}
+}
+
+func TestFileSHA256(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "archive.zip")
+	if err := os.WriteFile(filePath, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	sum, size, err := fileSHA256(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Errorf("unexpected checksum %s", sum)
+	}
+	if size != 5 {
+		t.Errorf("expected size 5, got %d", size)
+	}
+
+	if _, _, err := fileSHA256(filepath.Join(t.TempDir(), "missing.zip")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestExportProjectArchive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Rundeck-Auth-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/56/project/example/export":
+			_, _ = w.Write([]byte("sync-archive"))
+		case "/api/56/project/example/export/async":
+			if got := r.URL.Query().Get("exportJobs"); got != "true" {
+				t.Errorf("expected exportJobs=true, got %q", got)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"token": "abc", "ready": false, "percentage": 0}`))
+		case "/api/56/project/example/export/status/abc":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"token": "abc", "ready": true, "percentage": 100}`))
+		case "/api/56/project/example/export/download/abc":
+			_, _ = w.Write([]byte("async-archive"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+	query := url.Values{"exportJobs": {"true"}}
+
+	var archive bytes.Buffer
+	size, err := exportProjectArchive(context.Background(), clients, "example", query, true, time.Minute, &archive)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if archive.String() != "async-archive" || size != int64(archive.Len()) {
+		t.Errorf("unexpected archive %q (size %d)", archive.String(), size)
+	}
+
+	archive.Reset()
+	if _, err := exportProjectArchive(context.Background(), clients, "example", query, false, time.Minute, &archive); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if archive.String() != "sync-archive" {
+		t.Errorf("unexpected archive %q", archive.String())
+	}
+
+	if _, err := exportProjectArchive(context.Background(), clients, "missing", query, true, time.Minute, &archive); err == nil {
+		t.Error("expected an error for an unknown project")
+	}
+}
@@ -0,0 +1,150 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Project configuration can be changed one key at a time through
+// /project/<name>/config/<key>. Resources that own only some keys of a project
+// use these helpers so that keys managed elsewhere (by rundeck_project, other
+// resources or the GUI) are never rewritten.
+
+// projectConfigKeyValue is the JSON document for a single config key.
+type projectConfigKeyValue struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value"`
+}
+
+func projectConfigPath(project string) string {
+	return fmt.Sprintf("project/%s/config", url.PathEscape(project))
+}
+
+func projectConfigKeyPath(project, key string) string {
+	return fmt.Sprintf("project/%s/config/%s", url.PathEscape(project), url.PathEscape(key))
+}
+
+// getProjectConfig returns the whole configuration of a project. The status
+// code is returned so callers can handle a missing project.
+func getProjectConfig(ctx context.Context, clients *RundeckClients, project string) (map[string]string, int, error) {
+	config := map[string]string{}
+	status, err := rundeckAPIRequest(ctx, clients, http.MethodGet, projectConfigPath(project), nil, nil, &config)
+	if err != nil {
+		return nil, status, err
+	}
+	return config, status, nil
+}
+
+// putProjectConfigKey sets a single project config key, leaving all other
+// keys unchanged.
+func putProjectConfigKey(ctx context.Context, clients *RundeckClients, project, key, value string) error {
+	_, err := rundeckAPIRequest(ctx, clients, http.MethodPut, projectConfigKeyPath(project, key), nil, projectConfigKeyValue{Value: value}, nil)
+	return err
+}
+
+// deleteProjectConfigKey removes a single project config key. A key (or
+// project) that no longer exists is not an error.
+func deleteProjectConfigKey(ctx context.Context, clients *RundeckClients, project, key string) error {
+	status, err := rundeckAPIRequest(ctx, clients, http.MethodDelete, projectConfigKeyPath(project, key), nil, nil, nil)
+	if err != nil && status != 404 {
+		return err
+	}
+	return nil
+}
+
+// projectConfigMergeChanges works out the per-key changes for merge mode:
+// declared keys that are missing or have a different value are set, and keys
+// in removed are deleted. Every other key is left alone. Existing resource
+// model sources are only touched when sources are declared; they are then
+// fully declared, so sources beyond the declared ones are deleted.
+func projectConfigMergeChanges(current, declared map[string]string, removed []string) (map[string]string, []string) {
+	set := map[string]string{}
+	declaresSources := false
+	for k, v := range declared {
+		if strings.HasPrefix(k, projectModelSourcePrefix) {
+			declaresSources = true
+		}
+		if cur, ok := current[k]; !ok || cur != v {
+			set[k] = v
+		}
+	}
+
+	deleted := map[string]bool{}
+	for _, k := range removed {
+		if _, ok := declared[k]; ok {
+			continue
+		}
+		if _, ok := current[k]; ok {
+			deleted[k] = true
+		}
+	}
+	if declaresSources {
+		for k := range current {
+			if _, ok := declared[k]; !ok && strings.HasPrefix(k, projectModelSourcePrefix) {
+				deleted[k] = true
+			}
+		}
+	}
+
+	var del []string
+	for k := range deleted {
+		del = append(del, k)
+	}
+	sort.Strings(del)
+	return set, del
+}
+
+// mergeProjectConfigKeys applies merge mode changes one key at a time, so
+// keys written concurrently by the GUI or other resources are never lost.
+func mergeProjectConfigKeys(ctx context.Context, clients *RundeckClients, project string, declared map[string]string, removed []string) error {
+	current, _, err := getProjectConfig(ctx, clients, project)
+	if err != nil {
+		return fmt.Errorf("could not read current project configuration: %w", err)
+	}
+
+	set, del := projectConfigMergeChanges(current, declared, removed)
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := putProjectConfigKey(ctx, clients, project, k, set[k]); err != nil {
+			return fmt.Errorf("could not set %s: %w", k, err)
+		}
+	}
+	for _, k := range del {
+		if err := deleteProjectConfigKey(ctx, clients, project, k); err != nil {
+			return fmt.Errorf("could not remove %s: %w", k, err)
+		}
+	}
+	return nil
+}
+
+// projectGlobalsPrefix is the config key prefix of project global variables,
+// which jobs read as ${globals.<name>}.
+const projectGlobalsPrefix = "project.globals."
+
+// takeProjectGlobals removes the global variables from projectConfig and
+// returns them by name. When declared is non-nil only the declared names are
+// taken; other globals are left in projectConfig.
+func takeProjectGlobals(projectConfig map[string]interface{}, declared map[string]bool) map[string]string {
+	globals := map[string]string{}
+	for k, v := range projectConfig {
+		name, ok := strings.CutPrefix(k, projectGlobalsPrefix)
+		if !ok || name == "" {
+			continue
+		}
+		if declared != nil && !declared[name] {
+			continue
+		}
+		value, _ := v.(string)
+		globals[name] = value
+		delete(projectConfig, k)
+	}
+	return globals
+}
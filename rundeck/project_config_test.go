@@ -0,0 +1,169 @@
+package rundeck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestProjectConfigKeyHelpers(t *testing.T) {
+	config := map[string]string{"project.name": "example", "project.disable.executions": "false"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/56/project/example/config" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(config)
+		case r.URL.Path == "/api/56/project/example/config/project.disable.executions" && r.Method == http.MethodPut:
+			var body projectConfigKeyValue
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("could not decode request: %v", err)
+			}
+			config["project.disable.executions"] = body.Value
+			_ = json.NewEncoder(w).Encode(projectConfigKeyValue{Key: "project.disable.executions", Value: body.Value})
+		case r.URL.Path == "/api/56/project/example/config/project.disable.schedule" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+	ctx := context.Background()
+
+	if err := putProjectConfigKey(ctx, clients, "example", "project.disable.executions", "true"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, _, err := getProjectConfig(ctx, clients, "example")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got["project.disable.executions"] != "true" || got["project.name"] != "example" {
+		t.Errorf("unexpected config: %v", got)
+	}
+
+	// Deleting a key that does not exist is not an error
+	if err := deleteProjectConfigKey(ctx, clients, "example", "project.disable.schedule"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, status, err := getProjectConfig(ctx, clients, "missing"); err == nil || status != http.StatusNotFound {
+		t.Errorf("expected 404 for unknown project, got status %d, err %v", status, err)
+	}
+}
+
+func TestProjectConfigMergeChanges(t *testing.T) {
+	current := map[string]string{
+		"project.name":            "example",
+		"team.owner":              "payments",
+		"foo.bar":                 "old",
+		"same.key":                "same",
+		"old.key":                 "stale",
+		"resources.source.1.type": "file",
+		"resources.source.2.type": "local",
+	}
+
+	// Without declared sources the existing ones are left alone
+	set, deleted := projectConfigMergeChanges(current, map[string]string{
+		"foo.bar":  "new",
+		"same.key": "same",
+	}, []string{"old.key", "gone.key"})
+	if !reflect.DeepEqual(set, map[string]string{"foo.bar": "new"}) {
+		t.Errorf("unexpected keys to set: %v", set)
+	}
+	if !reflect.DeepEqual(deleted, []string{"old.key"}) {
+		t.Errorf("unexpected keys to delete: %v", deleted)
+	}
+
+	// Declared sources replace the existing ones
+	set, deleted = projectConfigMergeChanges(current, map[string]string{
+		"resources.source.1.type": "local",
+	}, nil)
+	if !reflect.DeepEqual(set, map[string]string{"resources.source.1.type": "local"}) {
+		t.Errorf("unexpected keys to set: %v", set)
+	}
+	if !reflect.DeepEqual(deleted, []string{"resources.source.2.type"}) {
+		t.Errorf("unexpected keys to delete: %v", deleted)
+	}
+}
+
+func TestMergeProjectConfigKeys(t *testing.T) {
+	config := map[string]string{
+		"project.name":            "example",
+		"team.owner":              "payments",
+		"foo.bar":                 "old",
+		"old.key":                 "stale",
+		"resources.source.1.type": "file",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		key := strings.TrimPrefix(r.URL.Path, "/api/56/project/example/config/")
+		switch {
+		case r.URL.Path == "/api/56/project/example/config" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(config)
+		case r.Method == http.MethodPut && key != r.URL.Path:
+			var body projectConfigKeyValue
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("could not decode request: %v", err)
+			}
+			config[key] = body.Value
+			_ = json.NewEncoder(w).Encode(projectConfigKeyValue{Key: key, Value: body.Value})
+		case r.Method == http.MethodDelete && key != r.URL.Path:
+			delete(config, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	clients := &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}
+
+	err := mergeProjectConfigKeys(context.Background(), clients, "example", map[string]string{"foo.bar": "new"}, []string{"old.key"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The undeclared key and the existing source survive the update
+	expected := map[string]string{
+		"project.name":            "example",
+		"team.owner":              "payments",
+		"foo.bar":                 "new",
+		"resources.source.1.type": "file",
+	}
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("expected %v, got %v", expected, config)
+	}
+}
+
+func TestTakeProjectGlobals(t *testing.T) {
+	projectConfig := map[string]interface{}{
+		"project.name":           "example",
+		"project.globals.region": "eu-west-1",
+		"project.globals.tier":   "prod",
+	}
+
+	got := takeProjectGlobals(projectConfig, map[string]bool{"region": true})
+	if !reflect.DeepEqual(got, map[string]string{"region": "eu-west-1"}) {
+		t.Errorf("unexpected declared globals: %v", got)
+	}
+	if _, ok := projectConfig["project.globals.tier"]; !ok {
+		t.Error("undeclared global must be left in the project config")
+	}
+
+	got = takeProjectGlobals(projectConfig, nil)
+	if !reflect.DeepEqual(got, map[string]string{"tier": "prod"}) {
+		t.Errorf("unexpected globals: %v", got)
+	}
+	if !reflect.DeepEqual(projectConfig, map[string]interface{}{"project.name": "example"}) {
+		t.Errorf("globals were not removed from the project config: %v", projectConfig)
+	}
+}
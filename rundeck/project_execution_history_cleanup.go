@@ -0,0 +1,166 @@
+package rundeck
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Project config keys of the scheduled execution history cleanup.
+const (
+	projectCleanupKeyPrefix        = "project.execution.history.cleanup."
+	projectCleanupEnabledKey       = "project.execution.history.cleanup.enabled"
+	projectCleanupRetentionDaysKey = "project.execution.history.cleanup.retention.days"
+	projectCleanupMinimumKey       = "project.execution.history.cleanup.retention.minimum"
+	projectCleanupBatchKey         = "project.execution.history.cleanup.batch"
+	projectCleanupScheduleKey      = "project.execution.history.cleanup.schedule"
+)
+
+// projectExecutionHistoryCleanupModel is the execution_history_cleanup block.
+type projectExecutionHistoryCleanupModel struct {
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	RetentionDays     types.Int64  `tfsdk:"retention_days"`
+	MinimumExecutions types.Int64  `tfsdk:"minimum_executions"`
+	BatchSize         types.Int64  `tfsdk:"batch_size"`
+	Schedule          types.String `tfsdk:"schedule"`
+}
+
+func projectExecutionHistoryCleanupAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":            types.BoolType,
+		"retention_days":     types.Int64Type,
+		"minimum_executions": types.Int64Type,
+		"batch_size":         types.Int64Type,
+		"schedule":           types.StringType,
+	}
+}
+
+func projectExecutionHistoryCleanupBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Scheduled cleanup of the project's execution history (project.execution.history.cleanup.* properties).",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether old executions are deleted on schedule.",
+				Required:    true,
+			},
+			"retention_days": schema.Int64Attribute{
+				Description: "Delete executions older than this many days.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"minimum_executions": schema.Int64Attribute{
+				Description: "Minimum number of executions to keep, regardless of age.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "Number of executions deleted per batch.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Quartz cron expression for when the cleanup runs, e.g. \"0 0 0 1/1 * ? *\".",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\s*\S+(\s+\S+){5,6}\s*$`),
+						"must be a Quartz cron expression with 6 or 7 fields",
+					),
+				},
+			},
+		},
+	}
+}
+
+// projectExecutionHistoryCleanupConfig returns the project config keys for
+// the block. Settings that are not set are omitted so Rundeck's defaults apply.
+func projectExecutionHistoryCleanupConfig(ctx context.Context, obj types.Object, diags *diag.Diagnostics) map[string]string {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	var cleanup projectExecutionHistoryCleanupModel
+	diags.Append(obj.As(ctx, &cleanup, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	config := map[string]string{}
+	if !cleanup.Enabled.IsNull() && !cleanup.Enabled.IsUnknown() {
+		config[projectCleanupEnabledKey] = strconv.FormatBool(cleanup.Enabled.ValueBool())
+	}
+	for key, v := range map[string]types.Int64{
+		projectCleanupRetentionDaysKey: cleanup.RetentionDays,
+		projectCleanupMinimumKey:       cleanup.MinimumExecutions,
+		projectCleanupBatchKey:         cleanup.BatchSize,
+	} {
+		if !v.IsNull() && !v.IsUnknown() {
+			config[key] = strconv.FormatInt(v.ValueInt64(), 10)
+		}
+	}
+	if !cleanup.Schedule.IsNull() && !cleanup.Schedule.IsUnknown() {
+		config[projectCleanupScheduleKey] = cleanup.Schedule.ValueString()
+	}
+	return config
+}
+
+// readProjectExecutionHistoryCleanup rebuilds the block from the project
+// configuration and removes the keys it consumes from projectConfig. When
+// prior is null the keys are left for extra_config.
+func readProjectExecutionHistoryCleanup(ctx context.Context, prior types.Object, projectConfig map[string]interface{}) (types.Object, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior, nil
+	}
+
+	take := func(key string) (string, bool) {
+		v, ok := projectConfig[key].(string)
+		if ok {
+			delete(projectConfig, key)
+		}
+		return v, ok
+	}
+	takeInt64 := func(key string) types.Int64 {
+		raw, ok := projectConfig[key].(string)
+		if !ok {
+			return types.Int64Null()
+		}
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			// Leave unparsable values in extra_config so the drift is visible
+			return types.Int64Null()
+		}
+		delete(projectConfig, key)
+		return types.Int64Value(n)
+	}
+
+	cleanup := projectExecutionHistoryCleanupModel{
+		// Rundeck treats a missing enabled key as disabled
+		Enabled:           types.BoolValue(false),
+		RetentionDays:     takeInt64(projectCleanupRetentionDaysKey),
+		MinimumExecutions: takeInt64(projectCleanupMinimumKey),
+		BatchSize:         takeInt64(projectCleanupBatchKey),
+		Schedule:          types.StringNull(),
+	}
+	if v, ok := take(projectCleanupEnabledKey); ok {
+		cleanup.Enabled = types.BoolValue(v == "true")
+	}
+	if v, ok := take(projectCleanupScheduleKey); ok {
+		cleanup.Schedule = types.StringValue(v)
+	}
+
+	return types.ObjectValueFrom(ctx, projectExecutionHistoryCleanupAttrTypes(), cleanup)
+}
@@ -0,0 +1,58 @@
+package rundeck
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectExecutionHistoryCleanup(t *testing.T) {
+	ctx := context.Background()
+	obj, diags := types.ObjectValueFrom(ctx, projectExecutionHistoryCleanupAttrTypes(), projectExecutionHistoryCleanupModel{
+		Enabled:           types.BoolValue(true),
+		RetentionDays:     types.Int64Value(30),
+		MinimumExecutions: types.Int64Value(100),
+		BatchSize:         types.Int64Null(),
+		Schedule:          types.StringValue("0 0 3 * * ? *"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var configDiags diag.Diagnostics
+	config := projectExecutionHistoryCleanupConfig(ctx, obj, &configDiags)
+	expected := map[string]string{
+		"project.execution.history.cleanup.enabled":           "true",
+		"project.execution.history.cleanup.retention.days":    "30",
+		"project.execution.history.cleanup.retention.minimum": "100",
+		"project.execution.history.cleanup.schedule":          "0 0 3 * * ? *",
+	}
+	if configDiags.HasError() || !reflect.DeepEqual(config, expected) {
+		t.Fatalf("unexpected config:\n got: %v\nwant: %v", config, expected)
+	}
+
+	projectConfig := map[string]interface{}{"project.label": "example"}
+	for k, v := range config {
+		projectConfig[k] = v
+	}
+	read, diags := readProjectExecutionHistoryCleanup(ctx, obj, projectConfig)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !read.Equal(obj) {
+		t.Errorf("read back block does not match:\n got: %v\nwant: %v", read, obj)
+	}
+	if !reflect.DeepEqual(projectConfig, map[string]interface{}{"project.label": "example"}) {
+		t.Errorf("cleanup keys were not consumed: %v", projectConfig)
+	}
+
+	// Without the block the keys are left for extra_config
+	projectConfig = map[string]interface{}{"project.execution.history.cleanup.enabled": "true"}
+	null := types.ObjectNull(projectExecutionHistoryCleanupAttrTypes())
+	if read, _ := readProjectExecutionHistoryCleanup(ctx, null, projectConfig); !read.IsNull() || len(projectConfig) != 1 {
+		t.Errorf("expected a null block and untouched config, got %v and %v", read, projectConfig)
+	}
+}
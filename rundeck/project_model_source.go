@@ -0,0 +1,147 @@
+package rundeck
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Resource model sources are stored as numbered project properties:
+//
+//	resources.source.<n>.type
+//	resources.source.<n>.label
+//	resources.source.<n>.config.<key>
+//
+// Rundeck only reads type and config; label is kept by the provider so that
+// sources can be matched to their blocks when the numbering changes.
+
+const projectModelSourcePrefix = "resources.source."
+
+// projectModelSource is a resource model source read from the project
+// configuration.
+type projectModelSource struct {
+	Index  int
+	Label  string
+	Type   string
+	Config map[string]string
+}
+
+// projectModelSourceKeys returns the project config keys for a source at
+// position n (1-based).
+func projectModelSourceKeys(n int, label, pluginType string, config map[string]string) map[string]string {
+	prefix := projectModelSourcePrefix + strconv.Itoa(n) + "."
+	keys := map[string]string{prefix + "type": pluginType}
+	if label != "" {
+		keys[prefix+"label"] = label
+	}
+	for k, v := range config {
+		keys[prefix+"config."+k] = v
+	}
+	return keys
+}
+
+// takeProjectModelSources removes every resources.source.* key from
+// projectConfig and returns the sources ordered by their number. Config keys
+// may themselves contain dots. Sources without a type are skipped.
+func takeProjectModelSources(projectConfig map[string]interface{}) []projectModelSource {
+	byIndex := map[int]*projectModelSource{}
+	for key, v := range projectConfig {
+		rest, ok := strings.CutPrefix(key, projectModelSourcePrefix)
+		if !ok {
+			continue
+		}
+		delete(projectConfig, key)
+
+		indexPart, field, ok := strings.Cut(rest, ".")
+		if !ok {
+			continue
+		}
+		index, err := strconv.Atoi(indexPart)
+		if err != nil {
+			continue
+		}
+		value, _ := v.(string)
+
+		source, ok := byIndex[index]
+		if !ok {
+			source = &projectModelSource{Index: index, Config: map[string]string{}}
+			byIndex[index] = source
+		}
+		switch {
+		case field == "type":
+			source.Type = value
+		case field == "label":
+			source.Label = value
+		case strings.HasPrefix(field, "config.") && len(field) > len("config."):
+			source.Config[strings.TrimPrefix(field, "config.")] = value
+		}
+	}
+
+	var sources []projectModelSource
+	for _, source := range byIndex {
+		if source.Type == "" {
+			continue
+		}
+		sources = append(sources, *source)
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Index < sources[j].Index })
+	return sources
+}
+
+// matchProjectModelSources orders sources to follow the prior blocks so that
+// renumbering on the server does not show up as drift. priorLabels holds the
+// label of each prior block ("" when unlabeled). Labeled blocks are matched by
+// label; unlabeled blocks take the remaining unlabeled sources in order.
+// Sources that match no prior block are appended in their server order.
+//
+// The returned slice gives, for each ordered source, the position of the
+// prior block it was matched with, or -1.
+func matchProjectModelSources(sources []projectModelSource, priorLabels []string) ([]projectModelSource, []int) {
+	used := make([]bool, len(sources))
+	matched := make([]int, len(priorLabels))
+	for i := range matched {
+		matched[i] = -1
+	}
+
+	for i, label := range priorLabels {
+		if label == "" {
+			continue
+		}
+		for j, source := range sources {
+			if !used[j] && source.Label == label {
+				matched[i], used[j] = j, true
+				break
+			}
+		}
+	}
+	for i, label := range priorLabels {
+		if label != "" {
+			continue
+		}
+		for j, source := range sources {
+			if !used[j] && source.Label == "" {
+				matched[i], used[j] = j, true
+				break
+			}
+		}
+	}
+
+	var (
+		ordered   []projectModelSource
+		priorRefs []int
+	)
+	for i, j := range matched {
+		if j < 0 {
+			continue
+		}
+		ordered = append(ordered, sources[j])
+		priorRefs = append(priorRefs, i)
+	}
+	for j, source := range sources {
+		if !used[j] {
+			ordered = append(ordered, source)
+			priorRefs = append(priorRefs, -1)
+		}
+	}
+	return ordered, priorRefs
+}
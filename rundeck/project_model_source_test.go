@@ -0,0 +1,79 @@
+package rundeck
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTakeProjectModelSources(t *testing.T) {
+	projectConfig := map[string]interface{}{
+		"project.name":                                    "example",
+		"resources.source.1.type":                         "local",
+		"resources.source.3.type":                         "file",
+		"resources.source.3.label":                        "inventory",
+		"resources.source.3.config.format":                "resourceyaml",
+		"resources.source.3.config.file":                  "/tmp/nodes.yaml",
+		"resources.source.3.config.requireFileExists":     "false",
+		"resources.source.3.config.plugin.dotted.key":     "kept",
+		"resources.source.4.config.file":                  "/tmp/orphan.yaml",
+		"resources.source.bogus.type":                     "file",
+		"resources.source.2.config.generateAutomatically": "true",
+	}
+
+	sources := takeProjectModelSources(projectConfig)
+	expected := []projectModelSource{
+		{Index: 1, Type: "local", Config: map[string]string{}},
+		{Index: 3, Label: "inventory", Type: "file", Config: map[string]string{
+			"format":            "resourceyaml",
+			"file":              "/tmp/nodes.yaml",
+			"requireFileExists": "false",
+			"plugin.dotted.key": "kept",
+		}},
+	}
+	if !reflect.DeepEqual(sources, expected) {
+		t.Errorf("unexpected sources:\n got: %+v\nwant: %+v", sources, expected)
+	}
+	if !reflect.DeepEqual(projectConfig, map[string]interface{}{"project.name": "example"}) {
+		t.Errorf("source keys were not removed: %v", projectConfig)
+	}
+
+	keys := projectModelSourceKeys(2, "inventory", "file", map[string]string{"file": "/tmp/nodes.yaml"})
+	expectedKeys := map[string]string{
+		"resources.source.2.type":        "file",
+		"resources.source.2.label":       "inventory",
+		"resources.source.2.config.file": "/tmp/nodes.yaml",
+	}
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Errorf("unexpected keys:\n got: %v\nwant: %v", keys, expectedKeys)
+	}
+}
+
+func TestMatchProjectModelSources(t *testing.T) {
+	// The server has renumbered the sources: the labeled ones swapped places
+	// and a new unlabeled source was added at the front.
+	sources := []projectModelSource{
+		{Index: 1, Type: "url"},
+		{Index: 2, Label: "b", Type: "file"},
+		{Index: 3, Label: "a", Type: "file"},
+		{Index: 4, Type: "local"},
+	}
+
+	ordered, priorRefs := matchProjectModelSources(sources, []string{"a", "", "b", "gone"})
+
+	var got []int
+	for _, s := range ordered {
+		got = append(got, s.Index)
+	}
+	if expected := []int{3, 1, 2, 4}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected order: got %v, want %v", got, expected)
+	}
+	if expected := []int{0, 1, 2, -1}; !reflect.DeepEqual(priorRefs, expected) {
+		t.Errorf("unexpected prior refs: got %v, want %v", priorRefs, expected)
+	}
+
+	// Without prior blocks, e.g. on import, the server order is kept
+	ordered, _ = matchProjectModelSources(sources, nil)
+	if !reflect.DeepEqual(ordered, sources) {
+		t.Errorf("expected server order, got %+v", ordered)
+	}
+}
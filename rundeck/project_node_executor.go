@@ -0,0 +1,395 @@
+package rundeck
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// projectPluginPropertyKind is the Terraform type of a typed plugin property.
+// Rundeck stores every property as a string.
+type projectPluginPropertyKind int
+
+const (
+	projectPluginString projectPluginPropertyKind = iota
+	projectPluginInt64
+	projectPluginBool
+)
+
+// projectPluginProperty maps one attribute of a typed node executor or file
+// copier block to its plugin configuration property.
+type projectPluginProperty struct {
+	Attribute        string
+	Key              string
+	Kind             projectPluginPropertyKind
+	Description      string
+	ExecutorOnly     bool
+	StringValidators []validator.String
+	Int64Validators  []validator.Int64
+}
+
+// projectPluginBlock describes one family of built-in plugins, such as SSH or
+// WinRM. The first plugin in each list is used when `plugin` is not set.
+type projectPluginBlock struct {
+	Name            string
+	Description     string
+	ExecutorPlugins []string
+	CopierPlugins   []string
+	Properties      []projectPluginProperty
+}
+
+// projectPluginService is the project default node executor or file copier.
+type projectPluginService struct {
+	Attribute    string
+	Description  string
+	ProviderKey  string
+	ConfigPrefix string
+	Executor     bool
+}
+
+var (
+	projectNodeExecutorService = projectPluginService{
+		Attribute:    "node_executor",
+		Description:  "Typed configuration of the default node executor. Exactly one plugin block must be set.",
+		ProviderKey:  "service.NodeExecutor.default.provider",
+		ConfigPrefix: "service.NodeExecutor.default.config.",
+		Executor:     true,
+	}
+	projectFileCopierService = projectPluginService{
+		Attribute:    "file_copier",
+		Description:  "Typed configuration of the default node file copier. Exactly one plugin block must be set.",
+		ProviderKey:  "service.FileCopier.default.provider",
+		ConfigPrefix: "service.FileCopier.default.config.",
+	}
+)
+
+var (
+	projectPluginTimeoutValidators = []validator.Int64{int64validator.AtLeast(0)}
+	projectPluginPortValidators    = []validator.Int64{int64validator.Between(1, 65535)}
+	projectPluginSSHAuthValidators = []validator.String{stringvalidator.OneOf("privateKey", "password")}
+)
+
+// projectPluginBlocks lists the built-in node executor and file copier
+// plugins that have a typed block on rundeck_project.
+var projectPluginBlocks = []projectPluginBlock{
+	{
+		Name:            "ssh",
+		Description:     "SSH executor or SCP file copier (sshj or JSch).",
+		ExecutorPlugins: []string{"sshj-ssh", "jsch-ssh"},
+		CopierPlugins:   []string{"sshj-scp", "jsch-scp"},
+		Properties: []projectPluginProperty{
+			{Attribute: "authentication", Key: "authentication", Description: "SSH authentication type: privateKey or password.", StringValidators: projectPluginSSHAuthValidators},
+			{Attribute: "key_file_path", Key: "keypath", Description: "Path to the SSH private key on the Rundeck server."},
+			{Attribute: "key_storage_path", Key: "keystoragepath", Description: "Key storage path of the SSH private key."},
+			{Attribute: "key_passphrase_storage_path", Key: "passphrasestoragepath", Description: "Key storage path of the SSH private key passphrase."},
+			{Attribute: "password_storage_path", Key: "passwordstoragepath", Description: "Key storage path of the SSH password."},
+			{Attribute: "port", Key: "ssh-port", Kind: projectPluginInt64, Description: "SSH port used when the node hostname does not include one.", Int64Validators: projectPluginPortValidators},
+			{Attribute: "connect_timeout", Key: "ssh-connect-timeout", Kind: projectPluginInt64, Description: "Connection timeout in milliseconds. 0 means no timeout.", Int64Validators: projectPluginTimeoutValidators},
+			{Attribute: "command_timeout", Key: "ssh-command-timeout", Kind: projectPluginInt64, Description: "Command timeout in milliseconds. 0 means no timeout.", Int64Validators: projectPluginTimeoutValidators},
+			{Attribute: "sudo_command_enabled", Key: "sudo-command-enabled", Kind: projectPluginBool, Description: "Respond to sudo password prompts.", ExecutorOnly: true},
+			{Attribute: "sudo_password_storage_path", Key: "sudoPasswordStoragePath", Description: "Key storage path of the sudo password.", ExecutorOnly: true},
+			{Attribute: "sudo_prompt_pattern", Key: "sudo-prompt-pattern", Description: "Regular expression matching the sudo password prompt.", ExecutorOnly: true},
+		},
+	},
+	{
+		Name:            "winrm",
+		Description:     "WinRM executor or file copier (pywinrm or Overthere).",
+		ExecutorPlugins: []string{"WinRMPython", "overthere-winrm"},
+		CopierPlugins:   []string{"WinRMcpPython", "overthere-winrm"},
+		Properties: []projectPluginProperty{
+			{Attribute: "authentication_type", Key: "authtype", Description: "WinRM authentication type: basic, ntlm, kerberos, credssp or certificate.", StringValidators: []validator.String{stringvalidator.OneOf("basic", "ntlm", "kerberos", "credssp", "certificate")}},
+			{Attribute: "transport", Key: "winrmtransport", Description: "WinRM transport: http or https.", StringValidators: []validator.String{stringvalidator.OneOf("http", "https")}},
+			{Attribute: "port", Key: "winrmport", Kind: projectPluginInt64, Description: "WinRM port.", Int64Validators: projectPluginPortValidators},
+			{Attribute: "username", Key: "username", Description: "Default user name for WinRM connections."},
+			{Attribute: "password_storage_path", Key: "password_storage_path", Description: "Key storage path of the WinRM password."},
+			{Attribute: "skip_certificate_validation", Key: "nossl", Kind: projectPluginBool, Description: "Do not validate the server certificate over https."},
+			{Attribute: "operation_timeout", Key: "operationtimeout", Kind: projectPluginInt64, Description: "WinRM operation timeout in seconds.", Int64Validators: projectPluginTimeoutValidators},
+			{Attribute: "read_timeout", Key: "readtimeout", Kind: projectPluginInt64, Description: "WinRM read timeout in seconds.", Int64Validators: projectPluginTimeoutValidators},
+			{Attribute: "kerberos_config_path", Key: "krb5config", Description: "Path to the krb5.conf file used for kerberos authentication."},
+			{Attribute: "kerberos_delegation", Key: "krbdelegation", Kind: projectPluginBool, Description: "Delegate kerberos credentials to the node."},
+			{Attribute: "shell", Key: "shell", Description: "Windows shell used to run commands: cmd or powershell.", ExecutorOnly: true, StringValidators: []validator.String{stringvalidator.OneOf("cmd", "powershell")}},
+		},
+	},
+	{
+		Name:            "ansible",
+		Description:     "Ansible executor or file copier.",
+		ExecutorPlugins: []string{"com.batix.rundeck.plugins.AnsibleNodeExecutor"},
+		CopierPlugins:   []string{"com.batix.rundeck.plugins.AnsibleFileCopier"},
+		Properties: []projectPluginProperty{
+			{Attribute: "executable", Key: "ansible-executable", Description: "Shell used by Ansible to run commands.", ExecutorOnly: true},
+			{Attribute: "config_file_path", Key: "ansible-config-file-path", Description: "Path to the ansible.cfg file on the Rundeck server."},
+			{Attribute: "ssh_user", Key: "ansible-ssh-user", Description: "SSH user name."},
+			{Attribute: "ssh_authentication", Key: "ansible-ssh-auth-type", Description: "SSH authentication type: privateKey or password.", StringValidators: projectPluginSSHAuthValidators},
+			{Attribute: "ssh_key_storage_path", Key: "ansible-ssh-key-storage-path", Description: "Key storage path of the SSH private key."},
+			{Attribute: "ssh_password_storage_path", Key: "ansible-ssh-password-storage-path", Description: "Key storage path of the SSH password."},
+			{Attribute: "ssh_timeout", Key: "ansible-ssh-timeout", Kind: projectPluginInt64, Description: "SSH timeout in seconds.", Int64Validators: projectPluginTimeoutValidators},
+			{Attribute: "become", Key: "ansible-become", Kind: projectPluginBool, Description: "Run with privilege escalation."},
+			{Attribute: "become_method", Key: "ansible-become-method", Description: "Privilege escalation method.", StringValidators: []validator.String{stringvalidator.OneOf("sudo", "su", "pbrun", "pfexec", "doas", "dzdo", "ksu", "runas")}},
+			{Attribute: "become_user", Key: "ansible-become-user", Description: "User to become."},
+			{Attribute: "become_password_storage_path", Key: "ansible-become-password-storage-path", Description: "Key storage path of the privilege escalation password."},
+			{Attribute: "vault_storage_path", Key: "ansible-vault-storage-path", Description: "Key storage path of the Ansible vault password."},
+		},
+	},
+	{
+		Name:            "local",
+		Description:     "Run commands on the Rundeck server itself.",
+		ExecutorPlugins: []string{"local"},
+	},
+}
+
+// blocks returns the plugin blocks available for the service.
+func (s projectPluginService) blocks() []projectPluginBlock {
+	var blocks []projectPluginBlock
+	for _, b := range projectPluginBlocks {
+		if len(s.plugins(b)) > 0 {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
+
+// plugins returns the provider names the block accepts for the service.
+func (s projectPluginService) plugins(b projectPluginBlock) []string {
+	if s.Executor {
+		return b.ExecutorPlugins
+	}
+	return b.CopierPlugins
+}
+
+// properties returns the block properties that apply to the service.
+func (s projectPluginService) properties(b projectPluginBlock) []projectPluginProperty {
+	var props []projectPluginProperty
+	for _, p := range b.Properties {
+		if p.ExecutorOnly && !s.Executor {
+			continue
+		}
+		props = append(props, p)
+	}
+	return props
+}
+
+// schemaBlock builds the single nested block for the service.
+func (s projectPluginService) schemaBlock() schema.SingleNestedBlock {
+	blocks := map[string]schema.Block{}
+	for _, b := range s.blocks() {
+		plugins := s.plugins(b)
+		attrs := map[string]schema.Attribute{}
+		if len(plugins) > 1 {
+			attrs["plugin"] = schema.StringAttribute{
+				Description: fmt.Sprintf("Plugin provider name: %s. Defaults to %s.", strings.Join(plugins, " or "), plugins[0]),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(plugins...),
+				},
+			}
+		}
+		for _, p := range s.properties(b) {
+			switch p.Kind {
+			case projectPluginInt64:
+				attrs[p.Attribute] = schema.Int64Attribute{Description: p.Description, Optional: true, Validators: p.Int64Validators}
+			case projectPluginBool:
+				attrs[p.Attribute] = schema.BoolAttribute{Description: p.Description, Optional: true}
+			default:
+				attrs[p.Attribute] = schema.StringAttribute{Description: p.Description, Optional: true, Validators: p.StringValidators}
+			}
+		}
+		blocks[b.Name] = schema.SingleNestedBlock{
+			Description: b.Description,
+			Attributes:  attrs,
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description: s.Description,
+		Blocks:      blocks,
+	}
+}
+
+// blockAttrTypes returns the attribute types of one plugin block.
+func (s projectPluginService) blockAttrTypes(b projectPluginBlock) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{}
+	if len(s.plugins(b)) > 1 {
+		attrTypes["plugin"] = types.StringType
+	}
+	for _, p := range s.properties(b) {
+		switch p.Kind {
+		case projectPluginInt64:
+			attrTypes[p.Attribute] = types.Int64Type
+		case projectPluginBool:
+			attrTypes[p.Attribute] = types.BoolType
+		default:
+			attrTypes[p.Attribute] = types.StringType
+		}
+	}
+	return attrTypes
+}
+
+// attrTypes returns the attribute types of the service block.
+func (s projectPluginService) attrTypes() map[string]attr.Type {
+	attrTypes := map[string]attr.Type{}
+	for _, b := range s.blocks() {
+		attrTypes[b.Name] = types.ObjectType{AttrTypes: s.blockAttrTypes(b)}
+	}
+	return attrTypes
+}
+
+// selected returns the plugin block that is set in obj, if exactly one is.
+func (s projectPluginService) selected(obj types.Object) (projectPluginBlock, types.Object, bool) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return projectPluginBlock{}, types.Object{}, false
+	}
+	var (
+		found    projectPluginBlock
+		foundObj types.Object
+		count    int
+	)
+	attrs := obj.Attributes()
+	for _, b := range s.blocks() {
+		v, ok := attrs[b.Name].(types.Object)
+		if !ok || v.IsNull() {
+			continue
+		}
+		found, foundObj = b, v
+		count++
+	}
+	return found, foundObj, count == 1
+}
+
+// provider returns the plugin provider name configured in obj. It is unknown
+// when the block's plugin attribute is not yet known.
+func (s projectPluginService) provider(obj types.Object) (types.String, bool) {
+	b, blockObj, ok := s.selected(obj)
+	if !ok {
+		return types.StringNull(), false
+	}
+	if blockObj.IsUnknown() {
+		return types.StringUnknown(), true
+	}
+	if v, ok := blockObj.Attributes()["plugin"].(types.String); ok && !v.IsNull() {
+		return v, true
+	}
+	return types.StringValue(s.plugins(b)[0]), true
+}
+
+// validate checks that exactly one plugin block is set.
+func (s projectPluginService) validate(obj types.Object, diags *diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return
+	}
+	if _, _, ok := s.selected(obj); ok {
+		return
+	}
+	var names []string
+	for _, b := range s.blocks() {
+		names = append(names, b.Name)
+	}
+	diags.AddAttributeError(
+		path.Root(s.Attribute),
+		"Invalid "+s.Attribute+" block",
+		fmt.Sprintf("Exactly one of the %s blocks must be set in %s.", strings.Join(names, ", "), s.Attribute),
+	)
+}
+
+// config returns the project configuration keys for obj: the provider and
+// every property that is set.
+func (s projectPluginService) config(obj types.Object) map[string]string {
+	b, blockObj, ok := s.selected(obj)
+	if !ok || blockObj.IsUnknown() {
+		return nil
+	}
+	config := map[string]string{}
+	if provider, _ := s.provider(obj); !provider.IsUnknown() {
+		config[s.ProviderKey] = provider.ValueString()
+	}
+	attrs := blockObj.Attributes()
+	for _, p := range s.properties(b) {
+		v := attrs[p.Attribute]
+		if v == nil || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		switch tv := v.(type) {
+		case types.Int64:
+			config[s.ConfigPrefix+p.Key] = strconv.FormatInt(tv.ValueInt64(), 10)
+		case types.Bool:
+			config[s.ConfigPrefix+p.Key] = strconv.FormatBool(tv.ValueBool())
+		case types.String:
+			config[s.ConfigPrefix+p.Key] = tv.ValueString()
+		}
+	}
+	return config
+}
+
+// read rebuilds the service block from the project configuration. Only the
+// plugin family set in prior is read back, and the keys it consumes are
+// removed from projectConfig so they do not also appear in extra_config.
+// When prior is null the keys are left for extra_config.
+func (s projectPluginService) read(prior types.Object, provider string, projectConfig map[string]interface{}) (types.Object, diag.Diagnostics) {
+	b, priorBlock, ok := s.selected(prior)
+	if !ok || priorBlock.IsUnknown() {
+		return prior, nil
+	}
+
+	priorAttrs := priorBlock.Attributes()
+	attrs := map[string]attr.Value{}
+	if len(s.plugins(b)) > 1 {
+		plugin, _ := priorAttrs["plugin"].(types.String)
+		switch {
+		case provider == "":
+			attrs["plugin"] = plugin
+		case plugin.IsNull() && provider == s.plugins(b)[0]:
+			attrs["plugin"] = types.StringNull()
+		default:
+			attrs["plugin"] = types.StringValue(provider)
+		}
+	}
+
+	for _, p := range s.properties(b) {
+		key := s.ConfigPrefix + p.Key
+		raw, _ := projectConfig[key].(string)
+		_, present := projectConfig[key]
+		switch p.Kind {
+		case projectPluginInt64:
+			attrs[p.Attribute] = types.Int64Null()
+			if n, err := strconv.ParseInt(raw, 10, 64); present && err == nil {
+				attrs[p.Attribute] = types.Int64Value(n)
+				delete(projectConfig, key)
+			}
+		case projectPluginBool:
+			attrs[p.Attribute] = types.BoolNull()
+			if v, err := strconv.ParseBool(raw); present && err == nil {
+				attrs[p.Attribute] = types.BoolValue(v)
+				delete(projectConfig, key)
+			}
+		default:
+			attrs[p.Attribute] = types.StringNull()
+			if present {
+				attrs[p.Attribute] = types.StringValue(raw)
+				delete(projectConfig, key)
+			}
+		}
+	}
+
+	var diags diag.Diagnostics
+	blocks := map[string]attr.Value{}
+	for _, other := range s.blocks() {
+		attrTypes := s.blockAttrTypes(other)
+		if other.Name != b.Name {
+			blocks[other.Name] = types.ObjectNull(attrTypes)
+			continue
+		}
+		blockObj, d := types.ObjectValue(attrTypes, attrs)
+		diags.Append(d...)
+		blocks[other.Name] = blockObj
+	}
+	obj, d := types.ObjectValue(s.attrTypes(), blocks)
+	diags.Append(d...)
+	return obj, diags
+}
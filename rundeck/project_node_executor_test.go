@@ -0,0 +1,131 @@
+package rundeck
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testProjectPluginObject builds a service block with only the named plugin
+// block set, leaving unspecified attributes null.
+func testProjectPluginObject(t *testing.T, svc projectPluginService, name string, values map[string]attr.Value) types.Object {
+	t.Helper()
+	blocks := map[string]attr.Value{}
+	for _, b := range svc.blocks() {
+		attrTypes := svc.blockAttrTypes(b)
+		if b.Name != name {
+			blocks[b.Name] = types.ObjectNull(attrTypes)
+			continue
+		}
+		attrs := map[string]attr.Value{}
+		for k, at := range attrTypes {
+			switch at {
+			case types.Int64Type:
+				attrs[k] = types.Int64Null()
+			case types.BoolType:
+				attrs[k] = types.BoolNull()
+			default:
+				attrs[k] = types.StringNull()
+			}
+		}
+		for k, v := range values {
+			attrs[k] = v
+		}
+		blocks[b.Name] = types.ObjectValueMust(attrTypes, attrs)
+	}
+	return types.ObjectValueMust(svc.attrTypes(), blocks)
+}
+
+func TestProjectPluginServiceConfig(t *testing.T) {
+	obj := testProjectPluginObject(t, projectNodeExecutorService, "ssh", map[string]attr.Value{
+		"plugin":               types.StringValue("jsch-ssh"),
+		"key_storage_path":     types.StringValue("keys/project/id_rsa"),
+		"connect_timeout":      types.Int64Value(30000),
+		"sudo_command_enabled": types.BoolValue(true),
+	})
+
+	expected := map[string]string{
+		"service.NodeExecutor.default.provider":                    "jsch-ssh",
+		"service.NodeExecutor.default.config.keystoragepath":       "keys/project/id_rsa",
+		"service.NodeExecutor.default.config.ssh-connect-timeout":  "30000",
+		"service.NodeExecutor.default.config.sudo-command-enabled": "true",
+	}
+	config := projectNodeExecutorService.config(obj)
+	if !reflect.DeepEqual(config, expected) {
+		t.Fatalf("unexpected config:\n got: %v\nwant: %v", config, expected)
+	}
+
+	// Read back consumes the typed keys and leaves the rest for extra_config.
+	projectConfig := map[string]interface{}{"foo.bar": "baz"}
+	for k, v := range config {
+		if k != projectNodeExecutorService.ProviderKey {
+			projectConfig[k] = v
+		}
+	}
+	read, diags := projectNodeExecutorService.read(obj, "jsch-ssh", projectConfig)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !read.Equal(obj) {
+		t.Errorf("read back block does not match:\n got: %v\nwant: %v", read, obj)
+	}
+	if !reflect.DeepEqual(projectConfig, map[string]interface{}{"foo.bar": "baz"}) {
+		t.Errorf("typed keys were not consumed: %v", projectConfig)
+	}
+}
+
+func TestProjectPluginServiceDefaults(t *testing.T) {
+	obj := testProjectPluginObject(t, projectFileCopierService, "winrm", map[string]attr.Value{
+		"authentication_type": types.StringValue("kerberos"),
+	})
+
+	provider, ok := projectFileCopierService.provider(obj)
+	if !ok || provider.ValueString() != "WinRMcpPython" {
+		t.Errorf("expected default provider WinRMcpPython, got %v", provider)
+	}
+
+	// The default plugin is read back as null so the plan stays empty.
+	read, _ := projectFileCopierService.read(obj, "WinRMcpPython", map[string]interface{}{
+		"service.FileCopier.default.config.authtype": "kerberos",
+	})
+	if !read.Equal(obj) {
+		t.Errorf("read back block does not match:\n got: %v\nwant: %v", read, obj)
+	}
+
+	// A provider changed outside Terraform shows up as drift.
+	read, _ = projectFileCopierService.read(obj, "overthere-winrm", map[string]interface{}{})
+	_, block, _ := projectFileCopierService.selected(read)
+	if got := block.Attributes()["plugin"]; !got.Equal(types.StringValue("overthere-winrm")) {
+		t.Errorf("expected plugin drift to overthere-winrm, got %v", got)
+	}
+
+	// Executor-only properties are not part of the file copier block.
+	if _, ok := projectFileCopierService.attrTypes()["local"]; ok {
+		t.Error("file_copier must not have a local block")
+	}
+	if _, ok := projectFileCopierService.blockAttrTypes(projectPluginBlocks[1])["shell"]; ok {
+		t.Error("file_copier winrm block must not have a shell attribute")
+	}
+}
+
+func TestProjectPluginServiceValidate(t *testing.T) {
+	var diags diag.Diagnostics
+	projectNodeExecutorService.validate(testProjectPluginObject(t, projectNodeExecutorService, "local", nil), &diags)
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	// An empty node_executor block selects no plugin.
+	blocks := map[string]attr.Value{}
+	for _, b := range projectNodeExecutorService.blocks() {
+		blocks[b.Name] = types.ObjectNull(projectNodeExecutorService.blockAttrTypes(b))
+	}
+	empty := types.ObjectValueMust(projectNodeExecutorService.attrTypes(), blocks)
+	projectNodeExecutorService.validate(empty, &diags)
+	if !diags.HasError() {
+		t.Error("expected an error for a node_executor block without a plugin block")
+	}
+}
@@ -0,0 +1,183 @@
+package rundeck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// =============================================================================
+// WRITABLE NODE SOURCES
+// =============================================================================
+//
+// Nodes are stored as one document per resource model source, so adding or
+// changing a single node means reading the whole document, changing one entry
+// and writing the document back. Terraform applies resources in parallel, so
+// every read-modify-write of a source is serialized with a per-source lock to
+// avoid one node resource overwriting another's change. Entries that are not
+// touched (including nodes managed outside Terraform) are written back as-is.
+// =============================================================================
+
+// nodeSourceLocks holds a *sync.Mutex per "project/index" source.
+var nodeSourceLocks sync.Map
+
+// nodeReservedAttributes are node attributes with their own schema fields.
+var nodeReservedAttributes = map[string]bool{
+	"nodename":    true,
+	"hostname":    true,
+	"username":    true,
+	"tags":        true,
+	"osFamily":    true,
+	"description": true,
+}
+
+// nodeSourceDocument is the parsed content of a writable node source.
+type nodeSourceDocument struct {
+	ContentType string
+	Nodes       map[string]map[string]interface{}
+}
+
+// lockNodeSource locks a node source and returns the unlock function.
+func lockNodeSource(project string, index int64) func() {
+	lock, _ := nodeSourceLocks.LoadOrStore(fmt.Sprintf("%s/%d", project, index), &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+func nodeSourcePath(project string, index int64) string {
+	return fmt.Sprintf("project/%s/source/%d/resources", url.PathEscape(project), index)
+}
+
+// getNodeSource reads and parses the nodes of a writable source.
+func getNodeSource(ctx context.Context, clients *RundeckClients, project string, index int64) (*nodeSourceDocument, error) {
+	resp, err := rundeckAPIRawRequest(ctx, clients, http.MethodGet, nodeSourcePath(project, index), nil, "", "", nil)
+	if err != nil {
+		if resp.StatusCode == 404 {
+			return nil, fmt.Errorf("resource model source %d of project %s was not found: %w", index, project, &NotFoundError{})
+		}
+		return nil, err
+	}
+
+	return parseNodeSource(resp.ContentType, resp.Body)
+}
+
+// putNodeSource writes the nodes back to the source in its own format.
+func putNodeSource(ctx context.Context, clients *RundeckClients, project string, index int64, doc *nodeSourceDocument) error {
+	body, err := renderNodeSource(doc)
+	if err != nil {
+		return err
+	}
+
+	_, err = rundeckAPIRawRequest(ctx, clients, http.MethodPost, nodeSourcePath(project, index), nil, doc.ContentType, "", body)
+	return err
+}
+
+// updateNodeSource applies a change to a source's nodes while holding the
+// source lock.
+func updateNodeSource(ctx context.Context, clients *RundeckClients, project string, index int64, update func(nodes map[string]map[string]interface{}) error) error {
+	unlock := lockNodeSource(project, index)
+	defer unlock()
+
+	doc, err := getNodeSource(ctx, clients, project, index)
+	if err != nil {
+		return err
+	}
+	if err := update(doc.Nodes); err != nil {
+		return err
+	}
+	return putNodeSource(ctx, clients, project, index, doc)
+}
+
+// parseNodeSource parses resourceyaml or resourcejson content. Both formats
+// are either a mapping of node name to attributes or a list of nodes.
+func parseNodeSource(contentType string, body []byte) (*nodeSourceDocument, error) {
+	mediaType := strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+	doc := &nodeSourceDocument{
+		ContentType: mediaType,
+		Nodes:       map[string]map[string]interface{}{},
+	}
+
+	switch {
+	case mediaType == "" || strings.HasSuffix(mediaType, "yaml"):
+		doc.ContentType = "application/yaml"
+	case strings.HasSuffix(mediaType, "json"):
+	default:
+		return nil, fmt.Errorf("unsupported node source format %q; the source must use the resourceyaml or resourcejson format", mediaType)
+	}
+
+	if strings.TrimSpace(string(body)) == "" {
+		return doc, nil
+	}
+
+	// JSON is valid YAML, so both formats are decoded the same way
+	var content interface{}
+	if err := yaml.Unmarshal(body, &content); err != nil {
+		return nil, fmt.Errorf("could not parse node source: %w", err)
+	}
+
+	switch nodes := content.(type) {
+	case nil:
+	case map[string]interface{}:
+		for name, value := range nodes {
+			attributes, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("node %q is not a mapping of attributes", name)
+			}
+			doc.Nodes[name] = attributes
+		}
+	case []interface{}:
+		for i, value := range nodes {
+			attributes, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("node %d is not a mapping of attributes", i)
+			}
+			name, _ := attributes["nodename"].(string)
+			if name == "" {
+				return nil, fmt.Errorf("node %d has no nodename", i)
+			}
+			doc.Nodes[name] = attributes
+		}
+	default:
+		return nil, fmt.Errorf("node source must be a mapping or list of nodes")
+	}
+
+	return doc, nil
+}
+
+// renderNodeSource renders the nodes as a mapping of node name to attributes.
+func renderNodeSource(doc *nodeSourceDocument) ([]byte, error) {
+	if strings.HasSuffix(doc.ContentType, "json") {
+		return json.MarshalIndent(doc.Nodes, "", "  ")
+	}
+	return yaml.Marshal(doc.Nodes)
+}
+
+// nodeTags parses the tags attribute, which may be a comma-separated string
+// or a list.
+func nodeTags(value interface{}) []string {
+	var tags []string
+	switch v := value.(type) {
+	case string:
+		for _, tag := range strings.Split(v, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	case []interface{}:
+		for _, tag := range v {
+			if s, ok := tag.(string); ok && strings.TrimSpace(s) != "" {
+				tags = append(tags, strings.TrimSpace(s))
+			}
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
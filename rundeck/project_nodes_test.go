@@ -0,0 +1,122 @@
+package rundeck
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNodeSource(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		body        string
+		wantType    string
+		wantNodes   []string
+	}{
+		{
+			name:        "yaml mapping",
+			contentType: "application/yaml;charset=UTF-8",
+			body:        "web1:\n  nodename: web1\n  hostname: 10.0.0.1\n  tags: web, linux\ndb1:\n  nodename: db1\n  hostname: 10.0.0.2\n",
+			wantType:    "application/yaml",
+			wantNodes:   []string{"db1", "web1"},
+		},
+		{
+			name:        "yaml list",
+			contentType: "text/yaml",
+			body:        "- nodename: web1\n  hostname: 10.0.0.1\n",
+			wantType:    "application/yaml",
+			wantNodes:   []string{"web1"},
+		},
+		{
+			name:        "json",
+			contentType: "application/json",
+			body:        `{"web1": {"nodename": "web1", "hostname": "10.0.0.1", "tags": ["web", "linux"]}}`,
+			wantType:    "application/json",
+			wantNodes:   []string{"web1"},
+		},
+		{
+			name:        "empty",
+			contentType: "",
+			body:        "",
+			wantType:    "application/yaml",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := parseNodeSource(tc.contentType, []byte(tc.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if doc.ContentType != tc.wantType {
+				t.Errorf("expected content type %q, got %q", tc.wantType, doc.ContentType)
+			}
+			var names []string
+			for name := range doc.Nodes {
+				names = append(names, name)
+			}
+			if len(names) != len(tc.wantNodes) {
+				t.Fatalf("expected nodes %v, got %v", tc.wantNodes, names)
+			}
+			for _, name := range tc.wantNodes {
+				if _, ok := doc.Nodes[name]; !ok {
+					t.Errorf("node %s not found", name)
+				}
+			}
+		})
+	}
+
+	if _, err := parseNodeSource("text/yaml", []byte("- hostname: 10.0.0.1\n")); err == nil || !strings.Contains(err.Error(), "no nodename") {
+		t.Errorf("expected missing nodename error, got %v", err)
+	}
+
+	if _, err := parseNodeSource("application/xml", []byte("<project/>")); err == nil || !strings.Contains(err.Error(), "resourceyaml or resourcejson") {
+		t.Errorf("expected unsupported format error, got %v", err)
+	}
+}
+
+func TestRenderNodeSource_preservesOtherNodes(t *testing.T) {
+	for _, contentType := range []string{"application/yaml", "application/json"} {
+		body := "other:\n  nodename: other\n  hostname: other.example.com\n  custom: 42\n"
+		if contentType == "application/json" {
+			body = `{"other": {"nodename": "other", "hostname": "other.example.com", "custom": 42}}`
+		}
+
+		doc, err := parseNodeSource(contentType, []byte(body))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", contentType, err)
+		}
+		doc.Nodes["web1"] = map[string]interface{}{"nodename": "web1", "hostname": "10.0.0.1"}
+
+		rendered, err := renderNodeSource(doc)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", contentType, err)
+		}
+		roundTrip, err := parseNodeSource(contentType, rendered)
+		if err != nil {
+			t.Fatalf("%s: could not parse rendered source: %v", contentType, err)
+		}
+		if !reflect.DeepEqual(roundTrip.Nodes["other"], doc.Nodes["other"]) {
+			t.Errorf("%s: other node changed: %v", contentType, roundTrip.Nodes["other"])
+		}
+		if roundTrip.Nodes["web1"]["hostname"] != "10.0.0.1" {
+			t.Errorf("%s: new node not written: %v", contentType, roundTrip.Nodes["web1"])
+		}
+	}
+}
+
+func TestNodeTags(t *testing.T) {
+	if got := nodeTags("web, linux,,prod "); !reflect.DeepEqual(got, []string{"linux", "prod", "web"}) {
+		t.Errorf("unexpected tags from string: %v", got)
+	}
+	if got := nodeTags([]interface{}{"web", "linux"}); !reflect.DeepEqual(got, []string{"linux", "web"}) {
+		t.Errorf("unexpected tags from list: %v", got)
+	}
+	if got := nodeTags([]interface{}{"web", nil, " "}); !reflect.DeepEqual(got, []string{"web"}) {
+		t.Errorf("unexpected tags from list with empty values: %v", got)
+	}
+	if got := nodeTags(nil); got != nil {
+		t.Errorf("expected no tags, got %v", got)
+	}
+}
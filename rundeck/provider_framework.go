@@ -207,6 +207,7 @@ func buildV2Configuration(apiURL *url.URL, apiVersion string, userAgentVersion s
 func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAclPolicyResource,
+		NewProjectAclPolicyResource,
 		NewPrivateKeyResource,
 		NewPublicKeyResource,
 		NewPasswordResource,
@@ -214,12 +215,26 @@ func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		NewProjectRunnerResource,
 		NewProjectResource,
 		NewJobResource,
+		NewJobDefinitionResource,
 		NewWebhookResource,
+		NewApiTokenResource,
+		NewExecutionResource,
+		NewAdhocCommandResource,
+		NewProjectNodeResource,
+		NewProjectReadmeResource,
+		NewProjectMotdResource,
+		NewProjectScmConfigResource,
+		NewProjectArchiveImportResource,
+		NewSystemExecutionModeResource,
+		NewProjectExecutionToggleResource,
+		NewProjectConfigKeyResource,
+		NewProjectGlobalVariableResource,
 	}
 }
 
 func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// Data sources will be added here if needed
+		NewProjectArchiveDataSource,
+		NewJobDocumentDataSource,
 	}
 }
@@ -16,9 +16,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &aclPolicyResource{}
-	_ resource.ResourceWithConfigure   = &aclPolicyResource{}
-	_ resource.ResourceWithImportState = &aclPolicyResource{}
+	_ resource.Resource                   = &aclPolicyResource{}
+	_ resource.ResourceWithConfigure      = &aclPolicyResource{}
+	_ resource.ResourceWithImportState    = &aclPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &aclPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &aclPolicyResource{}
 )
 
 // NewAclPolicyResource is a helper function to simplify the provider implementation.
@@ -33,9 +35,10 @@ type aclPolicyResource struct {
 
 // aclPolicyResourceModel describes the resource data model.
 type aclPolicyResourceModel struct {
-	ID     types.String `tfsdk:"id"`
-	Name   types.String `tfsdk:"name"`
-	Policy types.String `tfsdk:"policy"`
+	ID     types.String   `tfsdk:"id"`
+	Name   types.String   `tfsdk:"name"`
+	Policy AclPolicyValue `tfsdk:"policy"`
+	Rule   types.List     `tfsdk:"rule"`
 }
 
 // Metadata returns the resource type name.
@@ -63,10 +66,15 @@ func (r *aclPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"policy": schema.StringAttribute{
-				Description: "YAML formatted ACL Policy string.",
-				Required:    true,
+				Description: "YAML formatted ACL Policy string. Computed from the rule blocks when they are used instead. Formatting-only differences are ignored.",
+				CustomType:  AclPolicyType{},
+				Optional:    true,
+				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"rule": aclPolicyRuleNestedBlock(),
+		},
 	}
 }
 
@@ -88,6 +96,102 @@ func (r *aclPolicyResource) Configure(_ context.Context, req resource.ConfigureR
 	r.clients = clients
 }
 
+// ValidateConfig checks the policy YAML at plan time so that an invalid policy
+// is reported before any resources are changed. Rule blocks are rendered and
+// checked the same way as a raw policy.
+func (r *aclPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var policy AclPolicyValue
+	var rules types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy"), &policy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule"), &rules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasRules := !rules.IsNull() && (rules.IsUnknown() || len(rules.Elements()) > 0)
+	if !policy.IsNull() && hasRules {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rule"),
+			"Conflicting ACL policy configuration",
+			"Only one of policy or rule blocks can be set.",
+		)
+		return
+	}
+	if policy.IsNull() && !hasRules {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("policy"),
+			"Missing ACL policy",
+			"Either policy or at least one rule block must be set.",
+		)
+		return
+	}
+
+	attrPath := path.Root("policy")
+	if hasRules {
+		if !aclPolicyValueKnown(ctx, rules) {
+			return
+		}
+		rendered, diags := renderAclPolicyRules(ctx, rules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		policy = NewAclPolicyValue(rendered)
+		attrPath = path.Root("rule")
+	}
+	if policy.IsUnknown() {
+		return
+	}
+
+	for _, e := range validateAclPolicy(policy.ValueString(), false) {
+		resp.Diagnostics.AddAttributeError(
+			attrPath,
+			"Invalid ACL policy",
+			e.String(),
+		)
+	}
+}
+
+// ModifyPlan renders rule blocks into the planned policy so the YAML that will
+// be stored in Rundeck is shown in the plan and compared against the server.
+func (r *aclPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configPolicy AclPolicyValue
+	var rules types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy"), &configPolicy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule"), &rules)...)
+	if resp.Diagnostics.HasError() || !configPolicy.IsNull() || rules.IsNull() {
+		return
+	}
+
+	if !aclPolicyValueKnown(ctx, rules) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("policy"), AclPolicyValue{StringValue: types.StringUnknown()})...)
+		return
+	}
+
+	rendered, diags := renderAclPolicyRules(ctx, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the stored policy when it only differs from the rendered YAML in
+	// formatting, matching the semantic equality applied to a raw policy
+	if !req.State.Raw.IsNull() {
+		var statePolicy AclPolicyValue
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("policy"), &statePolicy)...)
+		if !statePolicy.IsNull() && !statePolicy.IsUnknown() && aclPoliciesEquivalent(statePolicy.ValueString(), rendered) {
+			rendered = statePolicy.ValueString()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("policy"), NewAclPolicyValue(rendered))...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *aclPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan aclPolicyResourceModel
@@ -162,7 +266,7 @@ func (r *aclPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Update the state
 	if response.Contents != nil {
-		state.Policy = types.StringValue(*response.Contents)
+		state.Policy = NewAclPolicyValue(*response.Contents)
 	}
 
 	// Save updated data into Terraform state
@@ -78,6 +78,31 @@ func TestAccAclPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccAclPolicy_rules(t *testing.T) {
+	var aclPolicy string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccAclPolicyCheckDestroy("TerraformRulesAcl.aclpolicy"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAclPolicyConfig_rules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccAclPolicyCheckExists("rundeck_acl_policy.test", &aclPolicy),
+					resource.TestCheckResourceAttr("rundeck_acl_policy.test", "policy", aclPolicyRulesRendered),
+					func(s *terraform.State) error {
+						if expected := aclPolicyRulesRendered; aclPolicy != expected {
+							return fmt.Errorf("acl policy does not match; expected (%v), got (%v)", expected, aclPolicy)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccAclPolicyCheckDestroy(policyName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Create client from environment variables for test verification
@@ -178,3 +203,65 @@ resource "rundeck_acl_policy" "test" {
 	policy = %q
 }
 `
+
+const testAccAclPolicyConfig_rules = `
+resource "rundeck_acl_policy" "test" {
+	name = "TerraformRulesAcl.aclpolicy"
+
+	rule {
+		description = "Developers can run jobs"
+		context {
+			project = ".*"
+		}
+		for {
+			resource_type = "job"
+			allow         = ["read", "run"]
+			match = {
+				group = "dev/.*"
+			}
+		}
+		by {
+			group = ["dev"]
+		}
+	}
+
+	rule {
+		description = "Developers can read projects"
+		context {
+			application = "rundeck"
+		}
+		for {
+			resource_type = "project"
+			allow         = ["read"]
+		}
+		by {
+			group = ["dev"]
+		}
+	}
+}
+`
+
+const aclPolicyRulesRendered = `description: Developers can run jobs
+context:
+  project: .*
+for:
+  job:
+    - match:
+        group: dev/.*
+      allow:
+        - read
+        - run
+by:
+  group:
+    - dev
+---
+description: Developers can read projects
+context:
+  application: rundeck
+for:
+  project:
+    - allow:
+        - read
+by:
+  group:
+    - dev`
@@ -0,0 +1,408 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &adhocCommandResource{}
+	_ resource.ResourceWithConfigure      = &adhocCommandResource{}
+	_ resource.ResourceWithValidateConfig = &adhocCommandResource{}
+)
+
+// NewAdhocCommandResource is a helper function to simplify the provider implementation.
+func NewAdhocCommandResource() resource.Resource {
+	return &adhocCommandResource{}
+}
+
+// adhocCommandResource is the resource implementation.
+type adhocCommandResource struct {
+	clients *RundeckClients
+}
+
+// adhocCommandResourceModel describes the resource data model.
+type adhocCommandResourceModel struct {
+	executionResultModel
+	Project           types.String `tfsdk:"project"`
+	Command           types.String `tfsdk:"command"`
+	Script            types.String `tfsdk:"script"`
+	ScriptArgs        types.String `tfsdk:"script_args"`
+	ScriptInterpreter types.String `tfsdk:"script_interpreter"`
+	NodeFilter        types.String `tfsdk:"node_filter"`
+	ThreadCount       types.Int64  `tfsdk:"thread_count"`
+	KeepGoing         types.Bool   `tfsdk:"keep_going"`
+	AsUser            types.String `tfsdk:"as_user"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	Timeout           types.String `tfsdk:"timeout"`
+	FailOnError       types.Bool   `tfsdk:"fail_on_error"`
+	LogTailLines      types.Int64  `tfsdk:"log_tail_lines"`
+	SuccessfulNodes   types.List   `tfsdk:"successful_nodes"`
+	FailedNodes       types.List   `tfsdk:"failed_nodes"`
+}
+
+// adhocRunRequest is the body of POST /project/{project}/run/command and
+// /project/{project}/run/script.
+type adhocRunRequest struct {
+	Exec              string `json:"exec,omitempty"`
+	Script            string `json:"script,omitempty"`
+	ArgString         string `json:"argString,omitempty"`
+	ScriptInterpreter string `json:"scriptInterpreter,omitempty"`
+	Filter            string `json:"filter"`
+	NodeThreadcount   int64  `json:"nodeThreadcount"`
+	NodeKeepgoing     bool   `json:"nodeKeepgoing"`
+	AsUser            string `json:"asUser,omitempty"`
+}
+
+// adhocRunResponse is returned when an ad-hoc execution is started.
+type adhocRunResponse struct {
+	Message   string        `json:"message"`
+	Execution ExecutionResp `json:"execution"`
+}
+
+// Metadata returns the resource type name.
+func (r *adhocCommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_adhoc_command"
+}
+
+// Schema defines the schema for the resource.
+func (r *adhocCommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Every input of the run forces a new execution
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	stateString := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+	stateList := []planmodifier.List{listplanmodifier.UseStateForUnknown()}
+
+	resp.Schema = schema.Schema{
+		Description: "Runs an ad-hoc command or inline script on the nodes of a Rundeck project and waits for it to finish. It runs again when any of its inputs or triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the execution.",
+				Computed:      true,
+				PlanModifiers: stateString,
+			},
+			"project": schema.StringAttribute{
+				Description:   "Name of the project to run in.",
+				Required:      true,
+				PlanModifiers: replaceString,
+			},
+			"command": schema.StringAttribute{
+				Description:   "Command to run on each node. Conflicts with script.",
+				Optional:      true,
+				PlanModifiers: replaceString,
+			},
+			"script": schema.StringAttribute{
+				Description:   "Inline script to copy to and run on each node. Conflicts with command.",
+				Optional:      true,
+				PlanModifiers: replaceString,
+			},
+			"script_args": schema.StringAttribute{
+				Description:   "Arguments passed to the script.",
+				Optional:      true,
+				PlanModifiers: replaceString,
+			},
+			"script_interpreter": schema.StringAttribute{
+				Description:   "Interpreter used to run the script, e.g. \"bash -c\".",
+				Optional:      true,
+				PlanModifiers: replaceString,
+			},
+			"node_filter": schema.StringAttribute{
+				Description:   "Node filter selecting the nodes to run on.",
+				Required:      true,
+				PlanModifiers: replaceString,
+			},
+			"thread_count": schema.Int64Attribute{
+				Description: "Number of nodes to run on in parallel. Defaults to 1.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"keep_going": schema.BoolAttribute{
+				Description: "Continue running on the remaining nodes when a node fails. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"as_user": schema.StringAttribute{
+				Description:   "Run the command as this user. Requires the runAs permission.",
+				Optional:      true,
+				PlanModifiers: replaceString,
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, run the command again.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the execution to finish, as a Go duration (e.g. \"30m\"). Defaults to \"30m\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("30m"),
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Description: "Fail the apply when the execution does not succeed. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"log_tail_lines": schema.Int64Attribute{
+				Description: "Number of log lines to record in log_tail. Defaults to 20; 0 disables it.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(20),
+			},
+			"status": schema.StringAttribute{
+				Description:   "Status of the execution, e.g. succeeded, failed, aborted or timedout.",
+				Computed:      true,
+				PlanModifiers: stateString,
+			},
+			"successful_nodes": schema.ListAttribute{
+				Description:   "Nodes the command succeeded on.",
+				ElementType:   types.StringType,
+				Computed:      true,
+				PlanModifiers: stateList,
+			},
+			"failed_nodes": schema.ListAttribute{
+				Description:   "Nodes the command failed on.",
+				ElementType:   types.StringType,
+				Computed:      true,
+				PlanModifiers: stateList,
+			},
+			"duration_ms": schema.Int64Attribute{
+				Description: "Duration of the execution in milliseconds.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_tail": schema.StringAttribute{
+				Description:   "The last log_tail_lines lines of log output.",
+				Computed:      true,
+				PlanModifiers: stateString,
+			},
+			"permalink": schema.StringAttribute{
+				Description:   "Link to the execution in the Rundeck GUI.",
+				Computed:      true,
+				PlanModifiers: stateString,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *adhocCommandResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// ValidateConfig checks that exactly one of command or script is set and that
+// the timeout is a valid duration.
+func (r *adhocCommandResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config adhocCommandResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Command.IsUnknown() && !config.Script.IsUnknown() && config.Command.IsNull() == config.Script.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("command"),
+			"Invalid ad-hoc command configuration",
+			"Exactly one of command or script must be set.",
+		)
+	}
+
+	if !config.Command.IsNull() && (!config.ScriptArgs.IsNull() || !config.ScriptInterpreter.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("command"),
+			"Invalid ad-hoc command configuration",
+			"script_args and script_interpreter can only be used with script.",
+		)
+	}
+
+	validatePositiveDuration(path.Root("timeout"), config.Timeout, &resp.Diagnostics)
+}
+
+// Create runs the command and sets the initial Terraform state.
+func (r *adhocCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan adhocCommandResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+
+	runRequest := adhocRunRequest{
+		Filter:          plan.NodeFilter.ValueString(),
+		NodeThreadcount: plan.ThreadCount.ValueInt64(),
+		NodeKeepgoing:   plan.KeepGoing.ValueBool(),
+		AsUser:          plan.AsUser.ValueString(),
+	}
+	endpoint := "run/command"
+	if plan.Command.IsNull() {
+		endpoint = "run/script"
+		runRequest.Script = plan.Script.ValueString()
+		runRequest.ArgString = plan.ScriptArgs.ValueString()
+		runRequest.ScriptInterpreter = plan.ScriptInterpreter.ValueString()
+	} else {
+		runRequest.Exec = plan.Command.ValueString()
+	}
+
+	var runResponse adhocRunResponse
+	_, err := rundeckAPIRequest(ctx, r.clients, http.MethodPost, "project/"+url.PathEscape(project)+"/"+endpoint, nil, runRequest, &runResponse)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error running ad-hoc command",
+			fmt.Sprintf("Could not run ad-hoc command in project %s: %s", project, err.Error()),
+		)
+		return
+	}
+
+	id := strconv.FormatInt(runResponse.Execution.ID, 10)
+	plan.ID = types.StringValue(id)
+
+	execution := &runResponse.Execution
+	timeout, _ := time.ParseDuration(plan.Timeout.ValueString())
+	finished, err := waitForExecution(ctx, r.clients, id, timeout)
+	if finished != nil {
+		execution = finished
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for ad-hoc command",
+			fmt.Sprintf("Execution %s in project %s did not finish: %s", id, project, err.Error()),
+		)
+	}
+
+	resp.Diagnostics.Append(setExecutionResult(ctx, r.clients, &plan.executionResultModel, execution, plan.LogTailLines.ValueInt64())...)
+	resp.Diagnostics.Append(setExecutionNodes(ctx, &plan, execution)...)
+
+	if plan.FailOnError.ValueBool() && !execution.IsRunning() && execution.Status != "succeeded" {
+		resp.Diagnostics.AddError(
+			"Ad-hoc command did not succeed",
+			fmt.Sprintf("Execution %s in project %s finished with status %s. Failed nodes: %v. See %s\n\n%s",
+				id, project, execution.Status, execution.FailedNodes, execution.Permalink, plan.LogTail.ValueString()),
+		)
+	}
+
+	// Save data into Terraform state. If the execution failed the resource is
+	// tainted, so the command runs again on the next apply.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *adhocCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state adhocCommandResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only executions that were still running (e.g. after a timeout) are
+	// refreshed
+	execution, diags := readExecutionResult(ctx, r.clients, &state.executionResultModel, state.LogTailLines.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || execution == nil {
+		return
+	}
+	resp.Diagnostics.Append(setExecutionNodes(ctx, &state, execution)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only changes settings that do not run the command again.
+func (r *adhocCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state adhocCommandResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.executionResultModel = state.executionResultModel
+	plan.SuccessfulNodes = state.SuccessfulNodes
+	plan.FailedNodes = state.FailedNodes
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the execution from the Terraform state. A command run cannot
+// be undone, so nothing is changed in Rundeck.
+func (r *adhocCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// setExecutionNodes copies the per-node results of the execution into the
+// model.
+func setExecutionNodes(ctx context.Context, model *adhocCommandResourceModel, execution *ExecutionResp) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	successfulNodes := execution.SuccessfulNodes
+	if successfulNodes == nil {
+		successfulNodes = []string{}
+	}
+	failedNodes := execution.FailedNodes
+	if failedNodes == nil {
+		failedNodes = []string{}
+	}
+	var d diag.Diagnostics
+	model.SuccessfulNodes, d = types.ListValueFrom(ctx, types.StringType, successfulNodes)
+	diags.Append(d...)
+	model.FailedNodes, d = types.ListValueFrom(ctx, types.StringType, failedNodes)
+	diags.Append(d...)
+
+	return diags
+}
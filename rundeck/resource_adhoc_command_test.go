@@ -0,0 +1,96 @@
+package rundeck
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAdhocCommand_command(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdhocCommandConfig_command,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("rundeck_adhoc_command.test", "id"),
+					resource.TestCheckResourceAttr("rundeck_adhoc_command.test", "status", "succeeded"),
+					resource.TestCheckResourceAttr("rundeck_adhoc_command.test", "successful_nodes.#", "1"),
+					resource.TestCheckResourceAttr("rundeck_adhoc_command.test", "failed_nodes.#", "0"),
+					resource.TestMatchResourceAttr("rundeck_adhoc_command.test", "log_tail", regexp.MustCompile("adhoc hello")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdhocCommand_script(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdhocCommandConfig_script,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_adhoc_command.test", "status", "succeeded"),
+					resource.TestCheckResourceAttr("rundeck_adhoc_command.test", "successful_nodes.#", "1"),
+					resource.TestMatchResourceAttr("rundeck_adhoc_command.test", "log_tail", regexp.MustCompile("script says first")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAdhocCommand_failure(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAdhocCommandConfig_failure,
+				ExpectError: regexp.MustCompile("finished with status failed"),
+			},
+		},
+	})
+}
+
+const testAccAdhocCommandConfig_project = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-adhoc"
+  description = "parent project for ad-hoc command acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+`
+
+const testAccAdhocCommandConfig_command = testAccAdhocCommandConfig_project + `
+resource "rundeck_adhoc_command" "test" {
+  project     = rundeck_project.test.name
+  command     = "echo adhoc hello"
+  node_filter = ".*"
+}
+`
+
+const testAccAdhocCommandConfig_script = testAccAdhocCommandConfig_project + `
+resource "rundeck_adhoc_command" "test" {
+  project     = rundeck_project.test.name
+  node_filter = ".*"
+  script      = <<-EOT
+    #!/bin/sh
+    echo "script says $1"
+  EOT
+  script_args = "first"
+}
+`
+
+const testAccAdhocCommandConfig_failure = testAccAdhocCommandConfig_project + `
+resource "rundeck_adhoc_command" "test" {
+  project     = rundeck_project.test.name
+  command     = "exit 3"
+  node_filter = ".*"
+}
+`
@@ -0,0 +1,330 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	openapi "github.com/rundeck/go-rundeck/rundeck-v2"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiTokenResource{}
+	_ resource.ResourceWithConfigure   = &apiTokenResource{}
+	_ resource.ResourceWithImportState = &apiTokenResource{}
+)
+
+// NewApiTokenResource is a helper function to simplify the provider implementation.
+func NewApiTokenResource() resource.Resource {
+	return &apiTokenResource{}
+}
+
+// apiTokenResource is the resource implementation.
+type apiTokenResource struct {
+	clients *RundeckClients
+}
+
+// apiTokenResourceModel describes the resource data model.
+type apiTokenResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	User       types.String `tfsdk:"user"`
+	Name       types.String `tfsdk:"name"`
+	Roles      types.Set    `tfsdk:"roles"`
+	Duration   types.String `tfsdk:"duration"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+	Token      types.String `tfsdk:"token"`
+	Expiration types.String `tfsdk:"expiration"`
+}
+
+// Metadata returns the resource type name.
+func (r *apiTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Rundeck user API token. Tokens cannot be modified, so any change creates a new token and revokes the old one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the token.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "The user the token authenticates as.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the token.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"roles": schema.SetAttribute{
+				Description: "Roles granted to the token. Use [\"*\"] to grant all roles of the user.",
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Description: "Token lifetime, e.g. \"30d\", \"12h\" or \"0\" for no expiration (subject to the server's maximum). Defaults to the server's default duration.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, rotate the token by creating a new one and revoking the old one.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The token value. Only available when the token is created.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Description: "Time the token expires (RFC 3339). Empty if the token does not expire.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user := plan.User.ValueString()
+
+	var roles []string
+	resp.Diagnostics.Append(plan.Roles.ElementsAs(ctx, &roles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createToken := openapi.NewCreateToken()
+	createToken.SetUser(user)
+	createToken.SetRoles(roles)
+	if !plan.Name.IsNull() {
+		createToken.SetName(plan.Name.ValueString())
+	}
+	if !plan.Duration.IsNull() {
+		createToken.SetDuration(plan.Duration.ValueString())
+	}
+
+	apiResp, httpResp, err := r.clients.V2.TokensAPI.ApiTokenCreate(r.clients.ctx, user).
+		ApiTokenCreateRequest(openapi.CreateTokenAsApiTokenCreateRequest(createToken)).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API token",
+			fmt.Sprintf("Could not create API token for user %s: %s", user, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+
+	id, _ := apiResp["id"].(string)
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Error creating API token",
+			fmt.Sprintf("Rundeck did not return a token ID for user %s", user),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	token, _ := apiResp["token"].(string)
+	plan.Token = types.StringValue(token)
+	expiration, _ := apiResp["expiration"].(string)
+	plan.Expiration = types.StringValue(expiration)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	token, httpResp, err := r.clients.V2.TokensAPI.ApiTokenGet(r.clients.ctx, id).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			// Token was revoked or removed, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading API token",
+			fmt.Sprintf("Could not read API token %s: %s", id, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+
+	// An expired token can no longer be used, so plan a new one
+	if token.GetExpired() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if token.User != nil {
+		state.User = types.StringValue(token.GetUser())
+	}
+	if token.Name != nil && token.GetName() != "" {
+		state.Name = types.StringValue(token.GetName())
+	}
+	if token.Roles != nil {
+		// Roles force a new token, so keep the configured ones unless the
+		// server really holds different roles
+		var prior []string
+		if !state.Roles.IsNull() && !state.Roles.IsUnknown() {
+			resp.Diagnostics.Append(state.Roles.ElementsAs(ctx, &prior, false)...)
+		}
+		if prior == nil || !apiTokenRolesEquivalent(prior, token.Roles) {
+			roles, diags := types.SetValueFrom(ctx, types.StringType, token.Roles)
+			resp.Diagnostics.Append(diags...)
+			state.Roles = roles
+		}
+	}
+	state.Expiration = types.StringValue(token.GetExpiration())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Every configurable attribute forces replacement, so there is nothing to send
+// to Rundeck; computed values are carried over from the prior state.
+func (r *apiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state apiTokenResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Token = state.Token
+	plan.Expiration = state.Expiration
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete revokes the token and removes the Terraform state on success.
+func (r *apiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	_, httpResp, err := r.clients.V2.TokensAPI.ApiTokenDelete(r.clients.ctx, id).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error revoking API token",
+			fmt.Sprintf("Could not revoke API token %s: %s", id, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state using the token ID.
+// The token value cannot be retrieved after creation, so it is left empty.
+func (r *apiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apiTokenRolesEquivalent reports whether the roles Rundeck returns for a token
+// match the configured ones. Rundeck expands "*" to the roles of the user, so
+// any set of roles matches it.
+func apiTokenRolesEquivalent(configured, server []string) bool {
+	want := map[string]bool{}
+	for _, role := range configured {
+		if role == "*" {
+			return true
+		}
+		want[role] = true
+	}
+	got := map[string]bool{}
+	for _, role := range server {
+		got[role] = true
+	}
+	if len(want) != len(got) {
+		return false
+	}
+	for role := range want {
+		if !got[role] {
+			return false
+		}
+	}
+	return true
+}
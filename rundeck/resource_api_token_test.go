@@ -0,0 +1,127 @@
+package rundeck
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccApiToken_basic(t *testing.T) {
+	var tokenID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccApiTokenCheckDestroy(&tokenID),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccApiTokenConfig_basic, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccApiTokenCheckExists("rundeck_api_token.test", &tokenID),
+					resource.TestCheckResourceAttr("rundeck_api_token.test", "user", "admin"),
+					resource.TestCheckResourceAttr("rundeck_api_token.test", "name", "terraform-acc-test-token"),
+					resource.TestCheckResourceAttr("rundeck_api_token.test", "roles.#", "1"),
+					resource.TestCheckResourceAttrSet("rundeck_api_token.test", "token"),
+					resource.TestCheckResourceAttrSet("rundeck_api_token.test", "expiration"),
+				),
+			},
+			{
+				// Changing a trigger rotates the token
+				Config: fmt.Sprintf(testAccApiTokenConfig_basic, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["rundeck_api_token.test"]
+						if rs.Primary.ID == tokenID {
+							return fmt.Errorf("expected token %s to be replaced after changing triggers", tokenID)
+						}
+						return testAccApiTokenCheckDestroy(&tokenID)(s)
+					},
+					testAccApiTokenCheckExists("rundeck_api_token.test", &tokenID),
+				),
+			},
+			{
+				ResourceName:            "rundeck_api_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "duration", "triggers"},
+			},
+		},
+	})
+}
+
+func TestApiTokenRolesEquivalent(t *testing.T) {
+	cases := []struct {
+		configured, server []string
+		expected           bool
+	}{
+		{[]string{"*"}, []string{"admin", "user"}, true},
+		{[]string{"admin", "user"}, []string{"user", "admin"}, true},
+		{[]string{"admin"}, []string{"admin", "user"}, false},
+		{[]string{"admin"}, []string{"user"}, false},
+	}
+	for _, c := range cases {
+		if got := apiTokenRolesEquivalent(c.configured, c.server); got != c.expected {
+			t.Errorf("roles %v vs %v: expected %v, got %v", c.configured, c.server, c.expected, got)
+		}
+	}
+}
+
+func testAccApiTokenCheckDestroy(tokenID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		_, httpResp, err := clients.V2.TokensAPI.ApiTokenGet(clients.ctx, *tokenID).Execute()
+		if err == nil || httpResp == nil || httpResp.StatusCode != 404 {
+			return fmt.Errorf("api token %s still exists", *tokenID)
+		}
+
+		return nil
+	}
+}
+
+func testAccApiTokenCheckExists(rn string, tokenID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("api token id not set")
+		}
+
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		_, httpResp, err := clients.V2.TokensAPI.ApiTokenGet(clients.ctx, rs.Primary.ID).Execute()
+		if err != nil {
+			return fmt.Errorf("Error getting API token: (%v) (%v)", rs.Primary.ID, httpResp)
+		}
+
+		*tokenID = rs.Primary.ID
+
+		return nil
+	}
+}
+
+const testAccApiTokenConfig_basic = `
+resource "rundeck_api_token" "test" {
+	user     = "admin"
+	name     = "terraform-acc-test-token"
+	roles    = ["admin"]
+	duration = "1d"
+
+	triggers = {
+		rotation = "%s"
+	}
+}
+`
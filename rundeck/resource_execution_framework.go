@@ -0,0 +1,320 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &executionResource{}
+	_ resource.ResourceWithConfigure      = &executionResource{}
+	_ resource.ResourceWithValidateConfig = &executionResource{}
+)
+
+// NewExecutionResource is a helper function to simplify the provider implementation.
+func NewExecutionResource() resource.Resource {
+	return &executionResource{}
+}
+
+// executionResource is the resource implementation.
+type executionResource struct {
+	clients *RundeckClients
+}
+
+// executionResourceModel describes the resource data model.
+type executionResourceModel struct {
+	executionResultModel
+	JobID             types.String `tfsdk:"job_id"`
+	Options           types.Map    `tfsdk:"options"`
+	NodeFilter        types.String `tfsdk:"node_filter"`
+	LogLevel          types.String `tfsdk:"log_level"`
+	AsUser            types.String `tfsdk:"as_user"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout           types.String `tfsdk:"timeout"`
+	FailOnError       types.Bool   `tfsdk:"fail_on_error"`
+	LogTailLines      types.Int64  `tfsdk:"log_tail_lines"`
+}
+
+// executionRunRequest is the body of POST /job/{id}/run.
+type executionRunRequest struct {
+	Options  map[string]string `json:"options,omitempty"`
+	Filter   string            `json:"filter,omitempty"`
+	LogLevel string            `json:"loglevel,omitempty"`
+	AsUser   string            `json:"asUser,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *executionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_execution"
+}
+
+// Schema defines the schema for the resource.
+func (r *executionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Rundeck job once when created, optionally waiting for it to finish. The job runs again when any of its inputs or triggers change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the execution.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				Description: "The ID of the job to run.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"options": schema.MapAttribute{
+				Description: "Job option values, keyed by option name.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_filter": schema.StringAttribute{
+				Description: "Node filter overriding the job's own filter.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log_level": schema.StringAttribute{
+				Description: "Log level for the execution: DEBUG, VERBOSE, INFO, WARN or ERROR.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("DEBUG", "VERBOSE", "INFO", "WARN", "ERROR"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"as_user": schema.StringAttribute{
+				Description: "Run the job as this user. Requires the runAs permission.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, run the job again.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Wait for the execution to finish before completing the apply. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the execution to finish, as a Go duration (e.g. \"30m\"). Defaults to \"30m\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("30m"),
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Description: "Fail the apply when the execution does not succeed. Only applies when waiting for completion. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"log_tail_lines": schema.Int64Attribute{
+				Description: "Number of log lines to record in log_tail once the execution finishes. Defaults to 20; 0 disables it.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(20),
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the execution, e.g. running, succeeded, failed, aborted or timedout.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration_ms": schema.Int64Attribute{
+				Description: "Duration of the execution in milliseconds. 0 while it is still running.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"log_tail": schema.StringAttribute{
+				Description: "The last log_tail_lines lines of log output.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permalink": schema.StringAttribute{
+				Description: "Link to the execution in the Rundeck GUI.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *executionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// ValidateConfig checks that the timeout is a valid duration.
+func (r *executionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeout types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validatePositiveDuration(path.Root("timeout"), timeout, &resp.Diagnostics)
+}
+
+// Create runs the job and sets the initial Terraform state.
+func (r *executionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan executionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobID := plan.JobID.ValueString()
+
+	runRequest := executionRunRequest{
+		Filter:   plan.NodeFilter.ValueString(),
+		LogLevel: plan.LogLevel.ValueString(),
+		AsUser:   plan.AsUser.ValueString(),
+	}
+	if !plan.Options.IsNull() {
+		resp.Diagnostics.Append(plan.Options.ElementsAs(ctx, &runRequest.Options, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	execution := &ExecutionResp{}
+	_, err := rundeckAPIRequest(ctx, r.clients, http.MethodPost, "job/"+url.PathEscape(jobID)+"/run", nil, runRequest, execution)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error running job",
+			fmt.Sprintf("Could not run job %s: %s", jobID, err.Error()),
+		)
+		return
+	}
+
+	id := strconv.FormatInt(execution.ID, 10)
+	plan.ID = types.StringValue(id)
+
+	if plan.WaitForCompletion.ValueBool() {
+		timeout, _ := time.ParseDuration(plan.Timeout.ValueString())
+		finished, err := waitForExecution(ctx, r.clients, id, timeout)
+		if finished != nil {
+			execution = finished
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for execution",
+				fmt.Sprintf("Execution %s of job %s did not finish: %s", id, jobID, err.Error()),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(setExecutionResult(ctx, r.clients, &plan.executionResultModel, execution, plan.LogTailLines.ValueInt64())...)
+
+	if plan.WaitForCompletion.ValueBool() && plan.FailOnError.ValueBool() && !execution.IsRunning() && execution.Status != "succeeded" {
+		resp.Diagnostics.AddError(
+			"Execution did not succeed",
+			fmt.Sprintf("Execution %s of job %s finished with status %s. See %s\n\n%s", id, jobID, execution.Status, execution.Permalink, plan.LogTail.ValueString()),
+		)
+	}
+
+	// Save data into Terraform state. If the execution failed the resource is
+	// tainted, so the job runs again on the next apply.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *executionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state executionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := readExecutionResult(ctx, r.clients, &state.executionResultModel, state.LogTailLines.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only changes settings that do not run the job again.
+func (r *executionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state executionResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.executionResultModel = state.executionResultModel
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the execution from the Terraform state. A job run cannot be
+// undone, so nothing is changed in Rundeck.
+func (r *executionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,125 @@
+package rundeck
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccExecution_basic(t *testing.T) {
+	var executionID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccExecutionConfig_basic, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("rundeck_execution.test", "id"),
+					resource.TestCheckResourceAttr("rundeck_execution.test", "status", "succeeded"),
+					resource.TestMatchResourceAttr("rundeck_execution.test", "log_tail", regexp.MustCompile("hello from terraform")),
+					resource.TestCheckResourceAttrSet("rundeck_execution.test", "permalink"),
+					func(s *terraform.State) error {
+						executionID = s.RootModule().Resources["rundeck_execution.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// Changing a trigger runs the job again
+				Config: fmt.Sprintf(testAccExecutionConfig_basic, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_execution.test", "status", "succeeded"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["rundeck_execution.test"].Primary.ID; id == executionID {
+							return fmt.Errorf("expected a new execution after changing triggers, still %s", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccExecution_failure(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExecutionConfig_failure,
+				ExpectError: regexp.MustCompile("finished with status failed"),
+			},
+		},
+	})
+}
+
+const testAccExecutionConfig_basic = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-execution"
+  description = "parent project for execution acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_job" "test" {
+  project_name      = rundeck_project.test.name
+  name              = "execution-test"
+  description       = "Job run by rundeck_execution"
+  execution_enabled = true
+
+  option {
+    name          = "greeting"
+    default_value = "hi"
+  }
+
+  command {
+    shell_command = "echo $${option.greeting}"
+  }
+}
+
+resource "rundeck_execution" "test" {
+  job_id = rundeck_job.test.id
+
+  options = {
+    greeting = "hello from terraform"
+  }
+
+  triggers = {
+    run = "%s"
+  }
+}
+`
+
+const testAccExecutionConfig_failure = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-execution-fail"
+  description = "parent project for execution acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_job" "test" {
+  project_name      = rundeck_project.test.name
+  name              = "execution-fail-test"
+  description       = "Job that always fails"
+  execution_enabled = true
+
+  command {
+    shell_command = "exit 1"
+  }
+}
+
+resource "rundeck_execution" "test" {
+  job_id = rundeck_job.test.id
+}
+`
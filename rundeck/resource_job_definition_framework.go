@@ -0,0 +1,334 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &jobDefinitionResource{}
+	_ resource.ResourceWithConfigure      = &jobDefinitionResource{}
+	_ resource.ResourceWithImportState    = &jobDefinitionResource{}
+	_ resource.ResourceWithModifyPlan     = &jobDefinitionResource{}
+	_ resource.ResourceWithValidateConfig = &jobDefinitionResource{}
+)
+
+// NewJobDefinitionResource is a helper function to simplify the provider implementation.
+func NewJobDefinitionResource() resource.Resource {
+	return &jobDefinitionResource{}
+}
+
+// jobDefinitionResource is the resource implementation.
+type jobDefinitionResource struct {
+	clients *RundeckClients
+}
+
+// jobDefinitionResourceModel describes the resource data model.
+type jobDefinitionResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Project    types.String `tfsdk:"project"`
+	Definition types.String `tfsdk:"definition"`
+	Name       types.String `tfsdk:"name"`
+	Group      types.String `tfsdk:"group"`
+}
+
+// Metadata returns the resource type name.
+func (r *jobDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_definition"
+}
+
+// Schema defines the schema for the resource.
+func (r *jobDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a job from a raw Rundeck job definition in YAML or JSON, such as a definition downloaded from the Rundeck GUI.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The UUID of the job.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project the job belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"definition": schema.StringAttribute{
+				Description: "The job definition in Rundeck's YAML or JSON job format. May be a single job or a list holding exactly one job.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the job, as stored by Rundeck.",
+				Computed:    true,
+			},
+			"group": schema.StringAttribute{
+				Description: "The group of the job, as stored by Rundeck.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jobDefinitionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// ValidateConfig checks that the definition can be parsed.
+func (r *jobDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jobDefinitionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Definition.IsNull() || config.Definition.IsUnknown() {
+		return
+	}
+	if _, err := parseJobDefinition(config.Definition.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definition"),
+			"Invalid job definition",
+			err.Error(),
+		)
+	}
+}
+
+// ModifyPlan sets name and group from the definition, so they are known in the
+// plan instead of showing as known after apply on every change.
+func (r *jobDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var definition types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("definition"), &definition)...)
+	if resp.Diagnostics.HasError() || definition.IsUnknown() || definition.IsNull() {
+		return
+	}
+
+	job, err := parseJobDefinition(definition.ValueString())
+	if err != nil {
+		// Reported by ValidateConfig
+		return
+	}
+
+	var model jobDefinitionResourceModel
+	setJobDefinitionComputed(&model, job)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), model.Name)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("group"), model.Group)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jobDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jobDefinitionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := parseJobDefinition(plan.Definition.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid job definition", err.Error())
+		return
+	}
+
+	// Never take over an existing job; an existing UUID fails the import
+	id, err := importJobDefinition(ctx, r.clients, plan.Project.ValueString(), job, "create")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating job",
+			fmt.Sprintf("Could not import job into project %s: %s", plan.Project.ValueString(), err.Error()),
+		)
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	if !r.readComputed(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jobDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jobDefinitionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := getJobDefinition(ctx, r.clients, state.ID.ValueString())
+	if err != nil {
+		if _, ok := err.(*NotFoundError); ok {
+			// Job was deleted outside Terraform, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading job",
+			fmt.Sprintf("Could not read job %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	setJobDefinitionComputed(&state, current)
+
+	// Keep the configured text while the job still matches it, so that
+	// formatting and fields filled in by Rundeck do not show up as drift
+	configured := state.Definition.ValueString()
+	if job, err := parseJobDefinition(configured); err == nil && jobDefinitionMatches(job, current) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	format := "yaml"
+	if configured != "" {
+		format = jobDefinitionFormat(configured)
+	}
+	definition, err := renderJobDefinition(current, format)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading job",
+			fmt.Sprintf("Could not render definition of job %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	state.Definition = types.StringValue(definition)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jobDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state jobDefinitionResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := parseJobDefinition(plan.Definition.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid job definition", err.Error())
+		return
+	}
+
+	// Always update the managed job, whatever UUID the definition carries
+	job["id"] = state.ID.ValueString()
+	job["uuid"] = state.ID.ValueString()
+
+	id, err := importJobDefinition(ctx, r.clients, plan.Project.ValueString(), job, "update")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating job",
+			fmt.Sprintf("Could not import job %s into project %s: %s", state.ID.ValueString(), plan.Project.ValueString(), err.Error()),
+		)
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	if !r.readComputed(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jobDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jobDefinitionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := rundeckAPIRequest(ctx, r.clients, http.MethodDelete, "job/"+url.PathEscape(state.ID.ValueString()), nil, nil, nil)
+	if err != nil && status != 404 {
+		resp.Diagnostics.AddError(
+			"Error deleting job",
+			fmt.Sprintf("Could not delete job %s: %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *jobDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import identifier in format 'project/job-uuid', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+// readComputed reads the job back after an import to fill in name and group.
+func (r *jobDefinitionResource) readComputed(ctx context.Context, model *jobDefinitionResourceModel, diags *diag.Diagnostics) bool {
+	current, err := getJobDefinition(ctx, r.clients, model.ID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading job after import",
+			fmt.Sprintf("Could not read job %s: %s", model.ID.ValueString(), err.Error()),
+		)
+		return false
+	}
+	setJobDefinitionComputed(model, current)
+	return true
+}
+
+// setJobDefinitionComputed sets the attributes taken from the stored job.
+func setJobDefinitionComputed(model *jobDefinitionResourceModel, job map[string]interface{}) {
+	name, _ := job["name"].(string)
+	model.Name = types.StringValue(name)
+	model.Group = types.StringNull()
+	if group, _ := job["group"].(string); group != "" {
+		model.Group = types.StringValue(group)
+	}
+}
@@ -0,0 +1,228 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJobDefinition_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccJobDefinitionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccJobDefinitionConfig, "Deploy the application"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_job_definition.test", "id", "5f0d1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"),
+					resource.TestCheckResourceAttr("rundeck_job_definition.test", "name", "deploy"),
+					resource.TestCheckResourceAttr("rundeck_job_definition.test", "group", "ops/release"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccJobDefinitionConfig, "Deploy the application to all web servers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_job_definition.test", "id", "5f0d1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"),
+					testAccJobDefinitionCheckField("rundeck_job_definition.test", "description", "Deploy the application to all web servers"),
+				),
+			},
+			// Defaults filled in by Rundeck must not show up as drift
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+			},
+			{
+				ResourceName:      "rundeck_job_definition.test",
+				ImportState:       true,
+				ImportStateId:     "terraform-acc-test-job-definition/5f0d1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b",
+				ImportStateVerify: true,
+				// An imported definition is rendered from the server
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+		},
+	})
+}
+
+func TestJobDefinitionResourceRead(t *testing.T) {
+	serverJob := testJobDefinitionServer
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/56/job/aaa" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(serverJob))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &jobDefinitionResource{clients: &RundeckClients{BaseURL: server.URL, APIVersion: "56", Token: "test-token"}}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	read := func() jobDefinitionResourceModel {
+		t.Helper()
+		state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+		prior := jobDefinitionResourceModel{
+			ID:         types.StringValue("aaa"),
+			Project:    types.StringValue("test-project"),
+			Definition: types.StringValue(testJobDefinitionYAML),
+			Name:       types.StringValue("deploy"),
+			Group:      types.StringValue("ops"),
+		}
+		if diags := state.Set(ctx, &prior); diags.HasError() {
+			t.Fatalf("could not set state: %v", diags)
+		}
+
+		resp := fwresource.ReadResponse{State: state}
+		r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+		}
+		var model jobDefinitionResourceModel
+		if diags := resp.State.Get(ctx, &model); diags.HasError() {
+			t.Fatalf("could not read state: %v", diags)
+		}
+		return model
+	}
+
+	// Defaults filled in by Rundeck are not drift
+	if got := read().Definition.ValueString(); got != testJobDefinitionYAML {
+		t.Errorf("expected the configured definition to be kept, got:\n%s", got)
+	}
+
+	// A schedule added outside Terraform is drift
+	serverJob = strings.Replace(testJobDefinitionServer, `"name": "deploy",`,
+		`"name": "deploy", "schedule": {"time": {"hour": "02", "minute": "00", "seconds": "0"}, "month": "*", "year": "*", "weekday": {"day": "*"}},`, 1)
+	got := read().Definition.ValueString()
+	if got == testJobDefinitionYAML || !strings.Contains(got, "schedule:") {
+		t.Errorf("expected the added schedule to show up in the definition, got:\n%s", got)
+	}
+}
+
+func TestJobDefinitionResourceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &jobDefinitionResource{}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	planned := jobDefinitionResourceModel{
+		ID:         types.StringValue("aaa"),
+		Project:    types.StringValue("test-project"),
+		Definition: types.StringValue(testJobDefinitionYAML),
+		Name:       types.StringUnknown(),
+		Group:      types.StringUnknown(),
+	}
+	if diags := plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatalf("could not set plan: %v", diags)
+	}
+
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var model jobDefinitionResourceModel
+	if diags := resp.Plan.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("could not read plan: %v", diags)
+	}
+	if model.Name.ValueString() != "deploy" || model.Group.ValueString() != "ops" {
+		t.Errorf("expected name and group from the definition, got %s and %s", model.Name, model.Group)
+	}
+}
+
+// testAccJobDefinitionCheckField checks a top-level field of the job stored in
+// Rundeck.
+func testAccJobDefinitionCheckField(name, field, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return err
+		}
+		job, err := getJobDefinition(context.Background(), clients, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if got := fmt.Sprint(job[field]); got != expected {
+			return fmt.Errorf("wrong %s; expected %q, got %q", field, expected, got)
+		}
+		return nil
+	}
+}
+
+func testAccJobDefinitionCheckDestroy(s *terraform.State) error {
+	// Create client from environment variables for test verification
+	clients, err := getTestClients()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "rundeck_job_definition" {
+			continue
+		}
+		_, err := getJobDefinition(context.Background(), clients, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("job %s still exists", rs.Primary.ID)
+		}
+		if _, ok := err.(*NotFoundError); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+const testAccJobDefinitionConfig = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-job-definition"
+  description = "parent project for job definition acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_job_definition" "test" {
+  project    = rundeck_project.test.name
+  definition = <<-EOT
+    - uuid: 5f0d1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b
+      name: deploy
+      group: ops/release
+      description: %s
+      loglevel: INFO
+      nodefilters:
+        dispatch:
+          threadcount: 2
+          keepgoing: false
+        filter: "name: localhost"
+      sequence:
+        commands:
+        - exec: echo deploying
+        - script: |
+            echo done
+  EOT
+}
+`
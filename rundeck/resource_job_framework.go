@@ -31,6 +31,14 @@ type jobResource struct {
 
 // jobResourceModel represents the Terraform resource model
 type jobResourceModel struct {
+	jobDocumentModel
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
+// jobDocumentModel holds the attributes that describe the job itself. It is
+// shared with the rundeck_job_document data source, which has no create-time
+// options such as adopt_existing.
+type jobDocumentModel struct {
 	ID                          types.String `tfsdk:"id"`
 	Name                        types.String `tfsdk:"name"`
 	GroupName                   types.String `tfsdk:"group_name"`
@@ -297,6 +305,12 @@ func (r *jobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			"time_zone": schema.StringAttribute{
 				Optional: true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On create, take over an existing job with the same project, group and name by updating it in place instead of creating a new job.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"command":                    jobCommandNestedBlock(),
@@ -364,25 +378,31 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 		return
 	}
 
+	validateJobConfig(ctx, &config, &resp.Diagnostics)
+}
+
+// validateJobConfig checks a rundeck_job configuration. It is shared with the
+// rundeck_job_document data source, which takes the same arguments.
+func validateJobConfig(ctx context.Context, config *jobResourceModel, diags *diag.Diagnostics) {
 	// Validate job references: run_for_each_node and node_step are aliases for the
 	// same API flag (nodeStep), so reject conflicting values (#256).
 	if !config.Command.IsNull() && !config.Command.IsUnknown() {
 		var commands []types.Object
-		resp.Diagnostics.Append(config.Command.ElementsAs(ctx, &commands, false)...)
-		if resp.Diagnostics.HasError() {
+		diags.Append(config.Command.ElementsAs(ctx, &commands, false)...)
+		if diags.HasError() {
 			return
 		}
 		for i, cmd := range commands {
 			cmdAttrs := cmd.Attributes()
 			validateJobRefNodeStep(ctx, cmdAttrs["job"],
-				path.Root("command").AtListIndex(i).AtName("job"), &resp.Diagnostics)
+				path.Root("command").AtListIndex(i).AtName("job"), diags)
 			if ehList, ok := cmdAttrs["error_handler"].(types.List); ok && !ehList.IsNull() && !ehList.IsUnknown() {
 				var ehs []types.Object
-				resp.Diagnostics.Append(ehList.ElementsAs(ctx, &ehs, false)...)
+				diags.Append(ehList.ElementsAs(ctx, &ehs, false)...)
 				for j, eh := range ehs {
 					validateJobRefNodeStep(ctx, eh.Attributes()["job"],
 						path.Root("command").AtListIndex(i).AtName("error_handler").AtListIndex(j).AtName("job"),
-						&resp.Diagnostics)
+						diags)
 				}
 			}
 		}
@@ -391,9 +411,9 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 	// Validate notifications: check for duplicates and alphabetical ordering
 	if !config.Notification.IsNull() && !config.Notification.IsUnknown() {
 		var notifications []types.Object
-		diags := config.Notification.ElementsAs(ctx, &notifications, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		listDiags := config.Notification.ElementsAs(ctx, &notifications, false)
+		diags.Append(listDiags...)
+		if diags.HasError() {
 			return
 		}
 
@@ -406,7 +426,7 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 				if typeStr, ok := typeAttr.(types.String); ok && !typeStr.IsNull() && !typeStr.IsUnknown() {
 					notifType := typeStr.ValueString()
 					if prevIdx, exists := seenTypes[notifType]; exists {
-						resp.Diagnostics.AddAttributeError(
+						diags.AddAttributeError(
 							path.Root("notification").AtListIndex(i).AtName("type"),
 							"Duplicate notification type",
 							fmt.Sprintf("Notification type %q is already defined at index %d. Each notification type can only be defined once.", notifType, prevIdx),
@@ -428,7 +448,7 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 			for i, actualType := range notificationTypes {
 				if actualType != sortedTypes[i] {
 					expectedOrder := strings.Join(sortedTypes, ", ")
-					resp.Diagnostics.AddAttributeError(
+					diags.AddAttributeError(
 						path.Root("notification").AtListIndex(i).AtName("type"),
 						"Invalid notification order",
 						fmt.Sprintf("Notifications must be defined in alphabetical order by type. Found %q at index %d, but expected %q. Expected order: %s", actualType, i, sortedTypes[i], expectedOrder),
@@ -443,9 +463,9 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 	// Validate options: check value_choices when require_predefined_choice is true
 	if !config.Option.IsNull() && !config.Option.IsUnknown() {
 		var options []types.Object
-		diags := config.Option.ElementsAs(ctx, &options, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		listDiags := config.Option.ElementsAs(ctx, &options, false)
+		diags.Append(listDiags...)
+		if diags.HasError() {
 			return
 		}
 
@@ -484,7 +504,7 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 				// Check value_choices
 				valueChoicesAttr, hasValueChoices := attrs["value_choices"]
 				if !hasValueChoices {
-					resp.Diagnostics.AddAttributeError(
+					diags.AddAttributeError(
 						path.Root("option").AtListIndex(i).AtName("value_choices"),
 						"Missing value choices",
 						fmt.Sprintf("Option %q has require_predefined_choice set to true, but value_choices is not provided. When require_predefined_choice is true, value_choices must contain at least one non-empty value.", optionName),
@@ -494,7 +514,7 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 
 				valueChoicesList, ok := valueChoicesAttr.(types.List)
 				if !ok || valueChoicesList.IsNull() {
-					resp.Diagnostics.AddAttributeError(
+					diags.AddAttributeError(
 						path.Root("option").AtListIndex(i).AtName("value_choices"),
 						"Missing value choices",
 						fmt.Sprintf("Option %q has require_predefined_choice set to true, but value_choices is not provided. When require_predefined_choice is true, value_choices must contain at least one non-empty value.", optionName),
@@ -510,9 +530,9 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 
 				// Extract string values from the list
 				var valueChoices []types.String
-				diags := valueChoicesList.ElementsAs(ctx, &valueChoices, false)
-				resp.Diagnostics.Append(diags...)
-				if resp.Diagnostics.HasError() {
+				listDiags := valueChoicesList.ElementsAs(ctx, &valueChoices, false)
+				diags.Append(listDiags...)
+				if diags.HasError() {
 					continue
 				}
 
@@ -544,14 +564,14 @@ func (r *jobResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 				}
 
 				if !hasNonEmptyValue {
-					resp.Diagnostics.AddAttributeError(
+					diags.AddAttributeError(
 						path.Root("option").AtListIndex(i).AtName("value_choices"),
 						"Empty value choices",
 						fmt.Sprintf("Option %q has require_predefined_choice set to true, but value_choices contains no non-empty values. When require_predefined_choice is true, value_choices must contain at least one non-empty value.", optionName),
 					)
 				} else if len(emptyValueIndices) > 0 {
 					// Error on empty values - API filters them out causing plan drift
-					resp.Diagnostics.AddAttributeError(
+					diags.AddAttributeError(
 						path.Root("option").AtListIndex(i).AtName("value_choices"),
 						"Empty value choices",
 						fmt.Sprintf("Option %q has require_predefined_choice set to true, but value_choices contains empty values at indices %v. Empty values are not allowed when require_predefined_choice is true as they cause plan drift (Rundeck API filters them out).", optionName, emptyValueIndices),
@@ -580,8 +600,29 @@ func (r *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	// Take over an existing job with the same name instead of creating a
+	// duplicate. The import then updates that job in place, keeping the
+	// fields this resource does not model.
+	jobDocument, existingID, err := jobCreateDocument(ctx, r.client, jobData, plan.AdoptExisting.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating job",
+			fmt.Sprintf("Could not adopt existing job: %s", err.Error()),
+		)
+		return
+	}
+	dupeOption := "create"
+	if existingID != "" {
+		dupeOption = "update"
+		resp.Diagnostics.AddWarning(
+			"Adopted existing job",
+			fmt.Sprintf("Job %q in project %s already existed (ID %s). It was updated in place and is now managed by Terraform.",
+				jobFullName(plan.GroupName.ValueString(), plan.Name.ValueString()), plan.ProjectName.ValueString(), existingID),
+		)
+	}
+
 	// Marshal to JSON
-	jobJSON, err := json.Marshal([]interface{}{jobData})
+	jobJSON, err := json.Marshal([]interface{}{jobDocument})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating job",
@@ -617,7 +658,7 @@ func (r *jobResource) Create(ctx context.Context, req resource.CreateRequest, re
 	// Add query parameters
 	q := httpReq.URL.Query()
 	q.Add("fileformat", "json")
-	q.Add("dupeOption", "create")
+	q.Add("dupeOption", dupeOption)
 	q.Add("uuidOption", "preserve")
 	httpReq.URL.RawQuery = q.Encode()
 
@@ -766,6 +807,9 @@ func (r *jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		)
 		return
 	}
+	if state.AdoptExisting.IsNull() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -791,8 +835,19 @@ func (r *jobResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Preserve the job ID for update
 	jobData.ID = plan.ID.ValueString()
 
+	// Merge over the current definition so that fields this resource does
+	// not model are not dropped by the update
+	jobDocument, err := jobUpdateDocument(ctx, r.client, jobData.ID, jobData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating job",
+			fmt.Sprintf("Could not merge job with its current definition: %s", err.Error()),
+		)
+		return
+	}
+
 	// Marshal to JSON
-	jobJSON, err := json.Marshal([]interface{}{jobData})
+	jobJSON, err := json.Marshal([]interface{}{jobDocument})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating job",
@@ -1677,3 +1732,11 @@ func (r *jobResource) sortNotificationsList(ctx context.Context, notificationsLi
 	// Create a new sorted list
 	return types.ListValueMust(elementType, notificationValues), diags
 }
+
+// jobFullName returns the group/name path of a job.
+func jobFullName(group, name string) string {
+	if group == "" {
+		return name
+	}
+	return group + "/" + name
+}
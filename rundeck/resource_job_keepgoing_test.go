@@ -62,14 +62,14 @@ func TestPlanToJobJSON_keepGoingFlagsAreIndependent(t *testing.T) {
 	for _, tc := range cases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			plan := &jobResourceModel{
+			plan := &jobResourceModel{jobDocumentModel: jobDocumentModel{
 				Name:                    types.StringValue("test-job"),
 				ProjectName:             types.StringValue("test-project"),
 				Description:             types.StringValue("desc"),
 				Command:                 testSingleShellCommandList(t),
 				ContinueOnError:         types.BoolValue(tc.continueOnError),
 				ContinueNextNodeOnError: types.BoolValue(tc.continueNextNode),
-			}
+			}}
 
 			job, err := r.planToJobJSON(context.Background(), plan)
 			if err != nil {
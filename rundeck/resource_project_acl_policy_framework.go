@@ -0,0 +1,292 @@
+package rundeck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &projectAclPolicyResource{}
+	_ resource.ResourceWithConfigure      = &projectAclPolicyResource{}
+	_ resource.ResourceWithImportState    = &projectAclPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &projectAclPolicyResource{}
+)
+
+// NewProjectAclPolicyResource is a helper function to simplify the provider implementation.
+func NewProjectAclPolicyResource() resource.Resource {
+	return &projectAclPolicyResource{}
+}
+
+// projectAclPolicyResource is the resource implementation.
+type projectAclPolicyResource struct {
+	clients *RundeckClients
+}
+
+// projectAclPolicyResourceModel describes the resource data model.
+type projectAclPolicyResourceModel struct {
+	ID      types.String   `tfsdk:"id"`
+	Project types.String   `tfsdk:"project"`
+	Name    types.String   `tfsdk:"name"`
+	Policy  AclPolicyValue `tfsdk:"policy"`
+}
+
+// projectAclPolicyContents is the JSON wrapper Rundeck uses for ACL policy
+// text when the request or response content type is application/json.
+type projectAclPolicyContents struct {
+	Contents string `json:"contents"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectAclPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_acl_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectAclPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Rundeck project-level ACL Policy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the ACL policy in the format project/name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project the ACL policy belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Unique name for the ACL policy within the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description: "YAML formatted ACL Policy string. Formatting-only differences are ignored.",
+				CustomType:  AclPolicyType{},
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectAclPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// ValidateConfig checks the policy YAML at plan time so that an invalid policy
+// is reported before any resources are changed.
+func (r *projectAclPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var policy AclPolicyValue
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy"), &policy)...)
+	if resp.Diagnostics.HasError() || policy.IsNull() || policy.IsUnknown() {
+		return
+	}
+
+	for _, e := range validateAclPolicy(policy.ValueString(), true) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("policy"),
+			"Invalid ACL policy",
+			e.String(),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectAclPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectAclPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	name := plan.Name.ValueString()
+
+	body, err := json.Marshal(projectAclPolicyContents{Contents: plan.Policy.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project ACL policy",
+			fmt.Sprintf("Could not encode ACL policy %s: %s", name, err.Error()),
+		)
+		return
+	}
+
+	// The V2 client sends string bodies as-is with a JSON content type, so the
+	// policy text is wrapped in Rundeck's {"contents": ...} document.
+	_, httpResp, err := r.clients.V2.ACLAPI.ApiProjectAclsPostDocs(r.clients.ctx, project, name).Body(string(body)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project ACL policy",
+			fmt.Sprintf("Could not create ACL policy %s in project %s: %s", name, project, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+
+	// Set the ID
+	plan.ID = types.StringValue(projectAclPolicyID(project, name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectAclPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectAclPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	name := state.Name.ValueString()
+
+	raw, httpResp, err := r.clients.V2.ACLAPI.ApiProjectAclsGetDocs(r.clients.ctx, project, name).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			// Resource no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading project ACL policy",
+			fmt.Sprintf("Could not read ACL policy %s in project %s: %s", name, project, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+
+	var contents projectAclPolicyContents
+	if err := json.Unmarshal([]byte(raw), &contents); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project ACL policy",
+			fmt.Sprintf("Could not parse ACL policy %s in project %s: %s", name, project, err.Error()),
+		)
+		return
+	}
+
+	// Update the state
+	state.Policy = NewAclPolicyValue(contents.Contents)
+	state.ID = types.StringValue(projectAclPolicyID(project, name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectAclPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectAclPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	name := plan.Name.ValueString()
+
+	body, err := json.Marshal(projectAclPolicyContents{Contents: plan.Policy.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project ACL policy",
+			fmt.Sprintf("Could not encode ACL policy %s: %s", name, err.Error()),
+		)
+		return
+	}
+
+	httpResp, err := r.clients.V2.ACLAPI.ApiProjectAclsPutDocs(r.clients.ctx, project, name).Body(string(body)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project ACL policy",
+			fmt.Sprintf("Could not update ACL policy %s in project %s: %s", name, project, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+
+	// Ensure ID is set (project/name)
+	plan.ID = types.StringValue(projectAclPolicyID(project, name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectAclPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectAclPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	name := state.Name.ValueString()
+
+	httpResp, err := r.clients.V2.ACLAPI.ApiProjectAclsDeleteDocs(r.clients.ctx, project, name).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting project ACL policy",
+			fmt.Sprintf("Could not delete ACL policy %s in project %s: %s", name, project, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *projectAclPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import identifier in format 'project/name', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// projectAclPolicyID builds the composite resource ID for a project ACL policy.
+func projectAclPolicyID(project, name string) string {
+	return project + "/" + name
+}
@@ -0,0 +1,140 @@
+package rundeck
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectAclPolicy_basic(t *testing.T) {
+	var aclPolicy string
+
+	initialConfig := fmt.Sprintf(testAccProjectAclPolicyConfig_basic, projectAclPolicyInitial)
+	updatedConfig := fmt.Sprintf(testAccProjectAclPolicyConfig_basic, projectAclPolicyUpdated)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectAclPolicyCheckDestroy("terraform-acc-test-project-acl", "TerraformProjectAcl.aclpolicy"),
+		Steps: []resource.TestStep{
+			{
+				Config: initialConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectAclPolicyCheckExists("rundeck_project_acl_policy.test", &aclPolicy),
+					resource.TestCheckResourceAttr("rundeck_project_acl_policy.test", "id", "terraform-acc-test-project-acl/TerraformProjectAcl.aclpolicy"),
+					resource.TestCheckResourceAttr("rundeck_project_acl_policy.test", "project", "terraform-acc-test-project-acl"),
+					resource.TestCheckResourceAttr("rundeck_project_acl_policy.test", "name", "TerraformProjectAcl.aclpolicy"),
+					func(s *terraform.State) error {
+						if expected := projectAclPolicyInitial; aclPolicy != expected {
+							return fmt.Errorf("initial acl policy does not match; expected (%v), got (%v)", expected, aclPolicy)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectAclPolicyCheckExists("rundeck_project_acl_policy.test", &aclPolicy),
+					func(s *terraform.State) error {
+						if expected := projectAclPolicyUpdated; aclPolicy != expected {
+							return fmt.Errorf("updated acl policy does not match; expected (%v), got (%v)", expected, aclPolicy)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "rundeck_project_acl_policy.test",
+				ImportStateId:     "terraform-acc-test-project-acl/TerraformProjectAcl.aclpolicy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectAclPolicyCheckDestroy(project, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		_, httpResp, err := clients.V2.ACLAPI.ApiProjectAclsGetDocs(clients.ctx, project, policyName).Execute()
+		if err == nil || httpResp == nil || httpResp.StatusCode != 404 {
+			return fmt.Errorf("project acl policy still exists")
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectAclPolicyCheckExists(rn string, aclPolicy *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("project acl policy id not set")
+		}
+
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		project := rs.Primary.Attributes["project"]
+		name := rs.Primary.Attributes["name"]
+		raw, httpResp, err := clients.V2.ACLAPI.ApiProjectAclsGetDocs(clients.ctx, project, name).Execute()
+		if err != nil {
+			return fmt.Errorf("Error getting project ACL policy: (%v) (%v)", rs.Primary.ID, httpResp)
+		}
+
+		var contents projectAclPolicyContents
+		if err := json.Unmarshal([]byte(raw), &contents); err != nil {
+			return fmt.Errorf("Error parsing project ACL policy: %s", err)
+		}
+		*aclPolicy = contents.Contents
+
+		return nil
+	}
+}
+
+const projectAclPolicyInitial = `description: Initial project ACL policy for testing.
+for:
+  job:
+    - allow: [read]
+by:
+  group: test-group`
+
+const projectAclPolicyUpdated = `description: Updated project ACL policy for testing.
+for:
+  job:
+    - allow: [read, run]
+by:
+  group: test-group`
+
+const testAccProjectAclPolicyConfig_basic = `
+resource "rundeck_project" "test" {
+	name        = "terraform-acc-test-project-acl"
+	description = "Project ACL policy acceptance test"
+
+	resource_model_source {
+		type = "local"
+	}
+}
+
+resource "rundeck_project_acl_policy" "test" {
+	project = rundeck_project.test.name
+	name    = "TerraformProjectAcl.aclpolicy"
+	policy  = %q
+}
+`
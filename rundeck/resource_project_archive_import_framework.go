@@ -0,0 +1,366 @@
+package rundeck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &projectArchiveImportResource{}
+	_ resource.ResourceWithConfigure  = &projectArchiveImportResource{}
+	_ resource.ResourceWithModifyPlan = &projectArchiveImportResource{}
+)
+
+// NewProjectArchiveImportResource is a helper function to simplify the provider implementation.
+func NewProjectArchiveImportResource() resource.Resource {
+	return &projectArchiveImportResource{}
+}
+
+// projectArchiveImportResource is the resource implementation.
+type projectArchiveImportResource struct {
+	clients *RundeckClients
+}
+
+// projectArchiveImportResourceModel describes the resource data model.
+type projectArchiveImportResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Project                 types.String `tfsdk:"project"`
+	ArchivePath             types.String `tfsdk:"archive_path"`
+	ArchiveSHA256           types.String `tfsdk:"archive_sha256"`
+	JobUUIDOption           types.String `tfsdk:"job_uuid_option"`
+	ImportExecutions        types.Bool   `tfsdk:"import_executions"`
+	ImportConfig            types.Bool   `tfsdk:"import_config"`
+	ImportACLs              types.Bool   `tfsdk:"import_acls"`
+	ImportSCM               types.Bool   `tfsdk:"import_scm"`
+	ImportWebhooks          types.Bool   `tfsdk:"import_webhooks"`
+	RegenerateWebhookTokens types.Bool   `tfsdk:"regenerate_webhook_tokens"`
+	ImportNodeSources       types.Bool   `tfsdk:"import_node_sources"`
+	ImportStatus            types.String `tfsdk:"import_status"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectArchiveImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_archive_import"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectArchiveImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports a project archive (.jar/.zip export) into a Rundeck project. The archive is imported again when its content or the import options change.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project to import into.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"archive_path": schema.StringAttribute{
+				Description: "Path of the local archive file to upload.",
+				Required:    true,
+			},
+			"archive_sha256": schema.StringAttribute{
+				Description: "SHA256 checksum of the imported archive. A different checksum at plan time imports the archive again.",
+				Computed:    true,
+			},
+			"job_uuid_option": schema.StringAttribute{
+				Description: "How job UUIDs in the archive are handled: preserve keeps them, remove generates new UUIDs. Defaults to preserve.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("preserve"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("preserve", "remove"),
+				},
+			},
+			"import_executions": schema.BoolAttribute{
+				Description: "Import executions and their logs. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"import_config": schema.BoolAttribute{
+				Description: "Import the project configuration. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"import_acls": schema.BoolAttribute{
+				Description: "Import the project ACL policies. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"import_scm": schema.BoolAttribute{
+				Description: "Import the SCM configuration. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"import_webhooks": schema.BoolAttribute{
+				Description: "Import webhooks. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"regenerate_webhook_tokens": schema.BoolAttribute{
+				Description: "Generate new auth tokens for imported webhooks instead of keeping the archived ones. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"import_node_sources": schema.BoolAttribute{
+				Description: "Import the node sources defined in the project configuration. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"import_status": schema.StringAttribute{
+				Description: "Import status reported by Rundeck: successful or failed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectArchiveImportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// ModifyPlan checksums the archive so that a changed file shows up in the plan
+// and triggers a new import.
+func (r *projectArchiveImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var archivePath types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("archive_path"), &archivePath)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if archivePath.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("archive_sha256"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("import_status"), types.StringUnknown())...)
+		return
+	}
+
+	sum, _, err := fileSHA256(archivePath.ValueString())
+	if errors.Is(err, fs.ErrNotExist) && !req.State.Raw.IsNull() {
+		// The archive was already imported; a temporary or generated file
+		// that was removed since must not block later plans
+		var stateSum types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("archive_sha256"), &stateSum)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("archive_sha256"), stateSum)...)
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("archive_path"),
+			"Project archive not found",
+			fmt.Sprintf("Archive %s no longer exists. It was already imported, so the recorded checksum is kept. Restore the file to import a changed archive.", archivePath.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("archive_path"),
+			"Error reading project archive",
+			fmt.Sprintf("Could not checksum archive %s: %s", archivePath.ValueString(), err.Error()),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("archive_sha256"), sum)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateSum types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("archive_sha256"), &stateSum)...)
+	if stateSum.ValueString() != sum {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("import_status"), types.StringUnknown())...)
+	}
+}
+
+// Create imports the archive and sets the initial Terraform state.
+func (r *projectArchiveImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectArchiveImportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := r.importArchive(ctx, &plan, &resp.Diagnostics)
+	if result == nil {
+		return
+	}
+
+	plan.ID = plan.Project
+	plan.ImportStatus = types.StringValue(result.ImportStatus)
+
+	// The state is saved even if parts of the import failed, which marks the
+	// resource as tainted so the archive is imported again on the next apply.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read checks that the project still exists. The imported content is not
+// tracked, as it is expected to change after import.
+func (r *projectArchiveImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectArchiveImportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	status, err := rundeckAPIRequest(ctx, r.clients, http.MethodGet, "project/"+url.PathEscape(project), nil, nil, nil)
+	if err != nil {
+		if status == 404 {
+			// Project no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading project",
+			fmt.Sprintf("Could not read project %s: %s", project, err.Error()),
+		)
+		return
+	}
+}
+
+// Update imports the archive again.
+func (r *projectArchiveImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state projectArchiveImportResourceModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := r.importArchive(ctx, &plan, &resp.Diagnostics)
+	if result == nil {
+		return
+	}
+
+	plan.ID = plan.Project
+	plan.ImportStatus = types.StringValue(result.ImportStatus)
+	if resp.Diagnostics.HasError() {
+		// Keep the previous checksum so the next apply tries again
+		plan.ArchiveSHA256 = state.ArchiveSHA256
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from state only. Imported jobs and other
+// content stay in the project.
+func (r *projectArchiveImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// importArchive uploads the archive and reports the errors of each part of
+// the import as diagnostics. A nil result means the import request failed.
+func (r *projectArchiveImportResource) importArchive(ctx context.Context, plan *projectArchiveImportResourceModel, diags *diag.Diagnostics) *projectImportResult {
+	project := plan.Project.ValueString()
+	archivePath := plan.ArchivePath.ValueString()
+
+	archive, err := os.ReadFile(archivePath)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("archive_path"),
+			"Error reading project archive",
+			fmt.Sprintf("Could not read archive %s: %s", archivePath, err.Error()),
+		)
+		return nil
+	}
+
+	query := url.Values{}
+	query.Set("jobUuidOption", plan.JobUUIDOption.ValueString())
+	query.Set("importExecutions", strconv.FormatBool(plan.ImportExecutions.ValueBool()))
+	query.Set("importConfig", strconv.FormatBool(plan.ImportConfig.ValueBool()))
+	query.Set("importACL", strconv.FormatBool(plan.ImportACLs.ValueBool()))
+	query.Set("importScm", strconv.FormatBool(plan.ImportSCM.ValueBool()))
+	query.Set("importWebhooks", strconv.FormatBool(plan.ImportWebhooks.ValueBool()))
+	query.Set("whkRegenAuthTokens", strconv.FormatBool(plan.RegenerateWebhookTokens.ValueBool()))
+	query.Set("importNodesSources", strconv.FormatBool(plan.ImportNodeSources.ValueBool()))
+
+	apiResp, err := rundeckAPIRawRequest(ctx, r.clients, http.MethodPut, "project/"+url.PathEscape(project)+"/import", query, "application/zip", "application/json", archive)
+	if err != nil {
+		diags.AddError(
+			"Error importing project archive",
+			fmt.Sprintf("Could not import archive %s into project %s: %s", archivePath, project, err.Error()),
+		)
+		return nil
+	}
+
+	result := &projectImportResult{}
+	if err := json.Unmarshal(apiResp.Body, result); err != nil {
+		diags.AddError(
+			"Error importing project archive",
+			fmt.Sprintf("Could not parse import result for project %s: %s", project, err.Error()),
+		)
+		return nil
+	}
+
+	for _, category := range result.errorCategories() {
+		diags.AddError(
+			fmt.Sprintf("%s import errors in project archive", category.Name),
+			fmt.Sprintf("Importing %s into project %s reported %d error(s):\n  - %s", archivePath, project, len(category.Errors), strings.Join(category.Errors, "\n  - ")),
+		)
+	}
+	if result.ImportStatus != "successful" && !diags.HasError() {
+		diags.AddError(
+			"Error importing project archive",
+			fmt.Sprintf("Import of %s into project %s finished with status %q", archivePath, project, result.ImportStatus),
+		)
+	}
+
+	return result
+}
@@ -0,0 +1,156 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestProjectArchiveImportModifyPlan_missingArchive(t *testing.T) {
+	ctx := context.Background()
+	r := &projectArchiveImportResource{}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	missing := filepath.Join(t.TempDir(), "missing.rdproject.jar")
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	for p, v := range map[string]string{"project": "payments", "archive_path": missing, "archive_sha256": "abc123"} {
+		if diags := state.SetAttribute(ctx, path.Root(p), v); diags.HasError() {
+			t.Fatalf("could not set %s: %v", p, diags)
+		}
+	}
+	plan := tfsdk.Plan{Schema: s, Raw: state.Raw.Copy()}
+
+	// After the import, a removed archive keeps the recorded checksum
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", resp.Diagnostics)
+	}
+	var sum types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("archive_sha256"), &sum)...)
+	if sum.ValueString() != "abc123" {
+		t.Errorf("expected the checksum from state, got %s", sum)
+	}
+
+	// On create the archive must exist
+	resp = fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a missing archive on create")
+	}
+}
+
+func TestAccProjectArchiveImport_basic(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "source.rdproject.jar")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectArchiveImportConfig_source,
+			},
+			{
+				PreConfig: func() {
+					if err := testAccExportProjectArchive("terraform-acc-test-archive-source", archivePath); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProjectArchiveImportConfig_source + fmt.Sprintf(testAccProjectArchiveImportConfig_import, archivePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_project_archive_import.test", "id", "terraform-acc-test-archive-target"),
+					resource.TestCheckResourceAttr("rundeck_project_archive_import.test", "import_status", "successful"),
+					resource.TestCheckResourceAttrSet("rundeck_project_archive_import.test", "archive_sha256"),
+					testAccProjectArchiveImportCheckJobs("terraform-acc-test-archive-target", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccExportProjectArchive(project, archivePath string) error {
+	// Create client from environment variables for test verification
+	clients, err := getTestClients()
+	if err != nil {
+		return fmt.Errorf("failed to create test client: %s", err)
+	}
+
+	resp, err := rundeckAPIRawRequest(context.Background(), clients, http.MethodGet, "project/"+project+"/export", nil, "", "application/zip", nil)
+	if err != nil {
+		return fmt.Errorf("Error exporting project %s: %s", project, err)
+	}
+	return os.WriteFile(archivePath, resp.Body, 0o600)
+}
+
+func testAccProjectArchiveImportCheckJobs(project string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		var jobs []map[string]interface{}
+		if _, err := rundeckAPIRequest(context.Background(), clients, http.MethodGet, "project/"+project+"/jobs", nil, nil, &jobs); err != nil {
+			return fmt.Errorf("Error listing jobs in project %s: %s", project, err)
+		}
+		if len(jobs) != expected {
+			return fmt.Errorf("expected %d imported job(s) in project %s, got %d", expected, project, len(jobs))
+		}
+
+		return nil
+	}
+}
+
+const testAccProjectArchiveImportConfig_source = `
+resource "rundeck_project" "source" {
+  name        = "terraform-acc-test-archive-source"
+  description = "source project for archive import acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_job" "source" {
+  project_name = rundeck_project.source.name
+  name         = "archived-job"
+  description  = "A job exported in the project archive"
+
+  command {
+    shell_command = "echo Hello World"
+  }
+}
+`
+
+const testAccProjectArchiveImportConfig_import = `
+resource "rundeck_project" "target" {
+  name        = "terraform-acc-test-archive-target"
+  description = "target project for archive import acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_project_archive_import" "test" {
+  project           = rundeck_project.target.name
+  archive_path      = "%s"
+  job_uuid_option   = "remove"
+  import_executions = false
+}
+`
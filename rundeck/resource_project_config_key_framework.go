@@ -0,0 +1,241 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectConfigKeyResource{}
+	_ resource.ResourceWithConfigure   = &projectConfigKeyResource{}
+	_ resource.ResourceWithImportState = &projectConfigKeyResource{}
+)
+
+// NewProjectConfigKeyResource is a helper function to simplify the provider implementation.
+func NewProjectConfigKeyResource() resource.Resource {
+	return &projectConfigKeyResource{}
+}
+
+// projectConfigKeyResource is the resource implementation.
+type projectConfigKeyResource struct {
+	clients *RundeckClients
+}
+
+// projectConfigKeyResourceModel describes the resource data model.
+type projectConfigKeyResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Project types.String `tfsdk:"project"`
+	Key     types.String `tfsdk:"key"`
+	Value   types.String `tfsdk:"value"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectConfigKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_config_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectConfigKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single configuration property of a Rundeck project without touching its other properties.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the property in the format project/key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Property key in dot notation, e.g. \"project.label\".",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Property value.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectConfigKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectConfigKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectConfigKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	key := plan.Key.ValueString()
+
+	if err := putProjectConfigKey(ctx, r.clients, project, key, plan.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project config key",
+			fmt.Sprintf("Could not set %s in project %s: %s", key, project, err.Error()),
+		)
+		return
+	}
+
+	// Set the ID
+	plan.ID = types.StringValue(projectConfigKeyID(project, key))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectConfigKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectConfigKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	key := state.Key.ValueString()
+
+	config, status, err := getProjectConfig(ctx, r.clients, project)
+	if err != nil {
+		if status == 404 {
+			// Project no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading project config key",
+			fmt.Sprintf("Could not read configuration of project %s: %s", project, err.Error()),
+		)
+		return
+	}
+
+	value, ok := config[key]
+	if !ok {
+		// Key was removed outside Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update the state
+	state.Value = types.StringValue(value)
+	state.ID = types.StringValue(projectConfigKeyID(project, key))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectConfigKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectConfigKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	key := plan.Key.ValueString()
+
+	if err := putProjectConfigKey(ctx, r.clients, project, key, plan.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project config key",
+			fmt.Sprintf("Could not set %s in project %s: %s", key, project, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(projectConfigKeyID(project, key))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectConfigKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectConfigKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	key := state.Key.ValueString()
+
+	if err := deleteProjectConfigKey(ctx, r.clients, project, key); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project config key",
+			fmt.Sprintf("Could not remove %s from project %s: %s", key, project, err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *projectConfigKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import identifier in format 'project/key', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), parts[1])...)
+}
+
+// projectConfigKeyID builds the composite resource ID for a project config key.
+func projectConfigKeyID(project, key string) string {
+	return project + "/" + key
+}
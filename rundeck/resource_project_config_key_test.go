@@ -0,0 +1,99 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectConfigKey_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectConfigKeyCheckDestroy("terraform-acc-test-config-key", "project.label"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectConfigKeyConfig, "Payments"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_project_config_key.label", "id", "terraform-acc-test-config-key/project.label"),
+					testAccProjectConfigKeyCheckValue("terraform-acc-test-config-key", "project.label", "Payments"),
+					testAccProjectConfigKeyCheckValue("terraform-acc-test-config-key", "project.description", "parent project for config key acceptance tests"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectConfigKeyConfig, "Payments (EU)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectConfigKeyCheckValue("terraform-acc-test-config-key", "project.label", "Payments (EU)"),
+					testAccProjectConfigKeyCheckValue("terraform-acc-test-config-key", "project.description", "parent project for config key acceptance tests"),
+				),
+			},
+			{
+				ResourceName:      "rundeck_project_config_key.label",
+				ImportState:       true,
+				ImportStateId:     "terraform-acc-test-config-key/project.label",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectConfigKeyCheckValue(project, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		config, _, err := getProjectConfig(context.Background(), clients, project)
+		if err != nil {
+			return fmt.Errorf("Error reading project config: %s", err)
+		}
+		if got := config[key]; got != expected {
+			return fmt.Errorf("%s: expected %q, got %q", key, expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectConfigKeyCheckDestroy(project, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		config, _, err := getProjectConfig(context.Background(), clients, project)
+		if err != nil {
+			// The project itself was destroyed
+			return nil
+		}
+		if _, ok := config[key]; ok {
+			return fmt.Errorf("%s still exists in project %s", key, project)
+		}
+
+		return nil
+	}
+}
+
+const testAccProjectConfigKeyConfig = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-config-key"
+  description = "parent project for config key acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_project_config_key" "label" {
+  project = rundeck_project.test.name
+  key     = "project.label"
+  value   = "%s"
+}
+`
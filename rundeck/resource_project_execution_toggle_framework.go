@@ -0,0 +1,237 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Project config keys that switch off executions and schedules of a project.
+const (
+	projectDisableExecutionsKey = "project.disable.executions"
+	projectDisableScheduleKey   = "project.disable.schedule"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectExecutionToggleResource{}
+	_ resource.ResourceWithConfigure   = &projectExecutionToggleResource{}
+	_ resource.ResourceWithImportState = &projectExecutionToggleResource{}
+)
+
+// NewProjectExecutionToggleResource is a helper function to simplify the provider implementation.
+func NewProjectExecutionToggleResource() resource.Resource {
+	return &projectExecutionToggleResource{}
+}
+
+// projectExecutionToggleResource is the resource implementation.
+type projectExecutionToggleResource struct {
+	clients *RundeckClients
+}
+
+// projectExecutionToggleResourceModel describes the resource data model.
+type projectExecutionToggleResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Project           types.String `tfsdk:"project"`
+	ExecutionsEnabled types.Bool   `tfsdk:"executions_enabled"`
+	SchedulesEnabled  types.Bool   `tfsdk:"schedules_enabled"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectExecutionToggleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_execution_toggle"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectExecutionToggleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables or disables executions and schedules of a single Rundeck project without managing the rest of its configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"executions_enabled": schema.BoolAttribute{
+				Description: "Whether jobs and ad-hoc commands can run in the project. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"schedules_enabled": schema.BoolAttribute{
+				Description: "Whether scheduled jobs of the project are triggered. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectExecutionToggleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// Create sets the toggles and the initial Terraform state.
+func (r *projectExecutionToggleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectExecutionToggleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setToggles(ctx, &plan, "Error creating project execution toggle", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the ID
+	plan.ID = plan.Project
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectExecutionToggleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectExecutionToggleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	config, status, err := getProjectConfig(ctx, r.clients, project)
+	if err != nil {
+		if status == 404 {
+			// Project no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading project execution toggle",
+			fmt.Sprintf("Could not read configuration of project %s: %s", project, err.Error()),
+		)
+		return
+	}
+
+	// Unset keys mean enabled
+	disableExecutions, _ := strconv.ParseBool(config[projectDisableExecutionsKey])
+	disableSchedule, _ := strconv.ParseBool(config[projectDisableScheduleKey])
+
+	// Update the state
+	state.ID = state.Project
+	state.ExecutionsEnabled = types.BoolValue(!disableExecutions)
+	state.SchedulesEnabled = types.BoolValue(!disableSchedule)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the toggles and sets the updated Terraform state on success.
+func (r *projectExecutionToggleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectExecutionToggleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setToggles(ctx, &plan, "Error updating project execution toggle", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Project
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes both keys, which enables executions and schedules again.
+func (r *projectExecutionToggleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectExecutionToggleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	for _, key := range []string{projectDisableExecutionsKey, projectDisableScheduleKey} {
+		if err := deleteProjectConfigKey(ctx, r.clients, project, key); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting project execution toggle",
+				fmt.Sprintf("Could not remove %s from project %s: %s", key, project, err.Error()),
+			)
+			return
+		}
+	}
+}
+
+// ImportState imports the resource into Terraform state using the project name.
+func (r *projectExecutionToggleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), req.ID)...)
+}
+
+// setToggles writes both keys individually so the rest of the project
+// configuration is left untouched.
+func (r *projectExecutionToggleResource) setToggles(ctx context.Context, plan *projectExecutionToggleResourceModel, summary string, diags *diag.Diagnostics) {
+	project := plan.Project.ValueString()
+	values := []struct {
+		key     string
+		enabled bool
+	}{
+		{projectDisableExecutionsKey, plan.ExecutionsEnabled.ValueBool()},
+		{projectDisableScheduleKey, plan.SchedulesEnabled.ValueBool()},
+	}
+
+	for _, v := range values {
+		if err := putProjectConfigKey(ctx, r.clients, project, v.key, strconv.FormatBool(!v.enabled)); err != nil {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("Could not set %s for project %s: %s", v.key, project, err.Error()),
+			)
+			return
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectExecutionToggle_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectExecutionToggleConfig, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_project_execution_toggle.test", "id", "terraform-acc-test-execution-toggle"),
+					testAccProjectExecutionToggleCheckConfig("terraform-acc-test-execution-toggle", "true", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectExecutionToggleConfig, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectExecutionToggleCheckConfig("terraform-acc-test-execution-toggle", "false", "true"),
+					// Project configuration managed by rundeck_project is untouched
+					resource.TestCheckResourceAttr("rundeck_project.test", "description", "parent project for execution toggle acceptance tests"),
+				),
+			},
+			{
+				ResourceName:      "rundeck_project_execution_toggle.test",
+				ImportState:       true,
+				ImportStateId:     "terraform-acc-test-execution-toggle",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectExecutionToggleCheckConfig(project, disableExecutions, disableSchedule string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		config, _, err := getProjectConfig(context.Background(), clients, project)
+		if err != nil {
+			return fmt.Errorf("Error reading project config: %s", err)
+		}
+		if got := config[projectDisableExecutionsKey]; got != disableExecutions {
+			return fmt.Errorf("%s: expected %s, got %s", projectDisableExecutionsKey, disableExecutions, got)
+		}
+		if got := config[projectDisableScheduleKey]; got != disableSchedule {
+			return fmt.Errorf("%s: expected %s, got %s", projectDisableScheduleKey, disableSchedule, got)
+		}
+		if got := config["project.description"]; got != "parent project for execution toggle acceptance tests" {
+			return fmt.Errorf("project.description was changed to %q", got)
+		}
+
+		return nil
+	}
+}
+
+const testAccProjectExecutionToggleConfig = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-execution-toggle"
+  description = "parent project for execution toggle acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_project_execution_toggle" "test" {
+  project            = rundeck_project.test.name
+  executions_enabled = %t
+  schedules_enabled  = %t
+}
+`
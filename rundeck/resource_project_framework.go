@@ -3,16 +3,20 @@ package rundeck
 import (
 	"context"
 	"fmt"
-	"sort"
-	"strconv"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,9 +27,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &projectResource{}
-	_ resource.ResourceWithConfigure   = &projectResource{}
-	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.Resource                   = &projectResource{}
+	_ resource.ResourceWithConfigure      = &projectResource{}
+	_ resource.ResourceWithImportState    = &projectResource{}
+	_ resource.ResourceWithValidateConfig = &projectResource{}
+	_ resource.ResourceWithModifyPlan     = &projectResource{}
 )
 
 // projectConfigAttributes maps Rundeck project config keys to Terraform attribute names
@@ -40,6 +46,19 @@ var projectConfigAttributes = map[string]string{
 	"project.ssh-keypath-file":              "ssh_key_file_path",
 }
 
+// projectGlobalVariableNameValidator checks the name of a project global
+// variable, which must be usable in a ${globals.<name>} reference.
+var projectGlobalVariableNameValidator = stringvalidator.RegexMatches(
+	regexp.MustCompile(`^[A-Za-z0-9_.-]+$`),
+	"must contain only letters, digits, '_', '.' and '-'",
+)
+
+// Values of config_management_mode
+const (
+	projectConfigModeAuthoritative = "authoritative"
+	projectConfigModeMerge         = "merge"
+)
+
 func NewProjectResource() resource.Resource {
 	return &projectResource{}
 }
@@ -60,13 +79,30 @@ type projectResourceModel struct {
 	SSHKeyStoragePath           types.String `tfsdk:"ssh_key_storage_path"`
 	SSHKeyFilePath              types.String `tfsdk:"ssh_key_file_path"`
 	ExtraConfig                 types.Map    `tfsdk:"extra_config"`
+	ConfigManagementMode        types.String `tfsdk:"config_management_mode"`
+	GlobalVariables             types.Map    `tfsdk:"global_variables"`
+	NodeExecutor                types.Object `tfsdk:"node_executor"`
+	FileCopier                  types.Object `tfsdk:"file_copier"`
+	ExecutionHistoryCleanup     types.Object `tfsdk:"execution_history_cleanup"`
+	DeletionProtection          types.Bool   `tfsdk:"deletion_protection"`
+	ArchiveOnDestroyPath        types.String `tfsdk:"archive_on_destroy_path"`
+	AdoptExisting               types.Bool   `tfsdk:"adopt_existing"`
 }
 
 type resourceModelSourceModel struct {
 	Type   types.String `tfsdk:"type"`
+	Label  types.String `tfsdk:"label"`
 	Config types.Map    `tfsdk:"config"`
 }
 
+func resourceModelSourceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":   types.StringType,
+		"label":  types.StringType,
+		"config": types.MapType{ElemType: types.StringType},
+	}
+}
+
 func (r *projectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
@@ -131,8 +167,47 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 				Computed:    true,
 			},
+			"global_variables": schema.MapAttribute{
+				Description: "Project global variables, available to jobs as ${globals.<name>}. Stored as project.globals.<name> properties.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(projectGlobalVariableNameValidator),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "When true, plans that destroy or replace the project fail. Set to false and apply before destroying the project.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"archive_on_destroy_path": schema.StringAttribute{
+				Description: "Local file the full project archive is exported to before the project is deleted. The project is not deleted if the export fails.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "On create, take over an existing project with the same name instead of failing. The project's configuration is then updated from this resource.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"config_management_mode": schema.StringAttribute{
+				Description: "How the project configuration is managed. \"authoritative\" (default) replaces the whole configuration and tracks every key in extra_config. \"merge\" only writes and tracks the keys declared in Terraform and leaves all other keys alone.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(projectConfigModeAuthoritative),
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectConfigModeAuthoritative, projectConfigModeMerge),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"node_executor":             projectNodeExecutorService.schemaBlock(),
+			"file_copier":               projectFileCopierService.schemaBlock(),
+			"execution_history_cleanup": projectExecutionHistoryCleanupBlock(),
 			"resource_model_source": schema.ListNestedBlock{
 				Description: "Resource model sources configuration.",
 				Validators: []validator.List{
@@ -144,6 +219,13 @@ func (r *projectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							Description: "The resource model source plugin type.",
 							Required:    true,
 						},
+						"label": schema.StringAttribute{
+							Description: "Stable label used to match the source to this block when sources are renumbered outside Terraform. Must be unique within the project.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
 						"config": schema.MapAttribute{
 							Description: "Configuration parameters for the resource model source.",
 							ElementType: types.StringType,
@@ -173,6 +255,129 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.clients = clients
 }
 
+// ValidateConfig checks the typed node executor and file copier blocks at
+// plan time.
+func (r *projectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config projectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectNodeExecutorService.validate(config.NodeExecutor, &resp.Diagnostics)
+	projectFileCopierService.validate(config.FileCopier, &resp.Diagnostics)
+
+	checkPlugin := func(svc projectPluginService, obj types.Object, plugin types.String, attrName string) {
+		provider, ok := svc.provider(obj)
+		if !ok || provider.IsUnknown() || plugin.IsNull() || plugin.IsUnknown() {
+			return
+		}
+		if plugin.ValueString() != provider.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attrName),
+				"Conflicting "+svc.Attribute+" plugin",
+				fmt.Sprintf("%s is %q but the %s block selects %q. Remove %s and set the plugin in the %s block instead.",
+					attrName, plugin.ValueString(), svc.Attribute, provider.ValueString(), attrName, svc.Attribute),
+			)
+		}
+	}
+	checkPlugin(projectNodeExecutorService, config.NodeExecutor, config.DefaultNodeExecutorPlugin, "default_node_executor_plugin")
+	checkPlugin(projectFileCopierService, config.FileCopier, config.DefaultNodeFileCopierPlugin, "default_node_file_copier_plugin")
+
+	// Source labels identify sources across renumbering, so they must be unique
+	if !config.ResourceModelSource.IsNull() && !config.ResourceModelSource.IsUnknown() {
+		var sources []resourceModelSourceModel
+		resp.Diagnostics.Append(config.ResourceModelSource.ElementsAs(ctx, &sources, false)...)
+		seen := map[string]bool{}
+		for i, source := range sources {
+			if source.Label.IsNull() || source.Label.IsUnknown() {
+				continue
+			}
+			label := source.Label.ValueString()
+			if seen[label] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("resource_model_source").AtListIndex(i).AtName("label"),
+					"Duplicate resource model source label",
+					fmt.Sprintf("The label %q is used by more than one resource_model_source block.", label),
+				)
+			}
+			seen[label] = true
+		}
+	}
+
+	// Cleanup settings belong in execution_history_cleanup once it is used
+	if !config.ExecutionHistoryCleanup.IsNull() && !config.ExtraConfig.IsNull() && !config.ExtraConfig.IsUnknown() {
+		for k := range config.ExtraConfig.Elements() {
+			if strings.HasPrefix(k, projectCleanupKeyPrefix) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("extra_config"),
+					"Conflicting execution history cleanup setting",
+					fmt.Sprintf("%q must be set in the execution_history_cleanup block instead of extra_config.", k),
+				)
+			}
+		}
+	}
+
+	// Globals belong in global_variables once it is used, otherwise both
+	// attributes would claim the same keys.
+	if !config.GlobalVariables.IsNull() && !config.ExtraConfig.IsNull() && !config.ExtraConfig.IsUnknown() {
+		for k := range config.ExtraConfig.Elements() {
+			if strings.HasPrefix(k, projectGlobalsPrefix) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("extra_config"),
+					"Conflicting global variable",
+					fmt.Sprintf("%q must be set in global_variables instead of extra_config when global_variables is used.", k),
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan enforces deletion_protection and sets the default node executor
+// and file copier plugins from the typed blocks when they are not configured
+// directly.
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() {
+		var state projectResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// name is the only attribute that forces replacement
+		replacing := false
+		if !req.Plan.Raw.IsNull() {
+			var planName types.String
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &planName)...)
+			replacing = !planName.IsUnknown() && !planName.Equal(state.Name)
+		}
+		if state.DeletionProtection.ValueBool() && (req.Plan.Raw.IsNull() || replacing) {
+			resp.Diagnostics.AddError(
+				"Project is protected from deletion",
+				fmt.Sprintf("Project %s has deletion_protection enabled and cannot be destroyed or replaced. Set deletion_protection = false and apply before destroying it.", state.ID.ValueString()),
+			)
+			return
+		}
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan projectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if provider, ok := projectNodeExecutorService.provider(plan.NodeExecutor); ok && config.DefaultNodeExecutorPlugin.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_node_executor_plugin"), provider)...)
+	}
+	if provider, ok := projectFileCopierService.provider(plan.FileCopier); ok && config.DefaultNodeFileCopierPlugin.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_node_file_copier_plugin"), provider)...)
+	}
+}
+
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan projectResourceModel
 
@@ -186,23 +391,42 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	name := plan.Name.ValueString()
 
 	// Check if project already exists
-	project, _ := client.ProjectGet(apiCtx, name)
-	if project.StatusCode != 404 {
+	project, err := client.ProjectGet(apiCtx, name)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Project already exists",
-			fmt.Sprintf("Project with unique name (%s) already exists", name),
+			"Error creating project",
+			fmt.Sprintf("Could not check whether project %s exists: %s", name, err.Error()),
 		)
 		return
 	}
-
-	// Create bare minimum project
-	_, err := client.ProjectCreate(apiCtx, rundeck.ProjectCreateRequest{
-		Name: &name,
-	})
-	if err != nil {
+	switch {
+	case project.StatusCode == 404:
+		// Create bare minimum project
+		_, err = client.ProjectCreate(apiCtx, rundeck.ProjectCreateRequest{
+			Name: &name,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating project",
+				fmt.Sprintf("Could not create project: %s", err.Error()),
+			)
+			return
+		}
+	case project.StatusCode != 200:
 		resp.Diagnostics.AddError(
 			"Error creating project",
-			fmt.Sprintf("Could not create project: %s", err.Error()),
+			fmt.Sprintf("Could not check whether project %s exists: unexpected status %d", name, project.StatusCode),
+		)
+		return
+	case plan.AdoptExisting.ValueBool():
+		resp.Diagnostics.AddWarning(
+			"Adopted existing project",
+			fmt.Sprintf("Project %s already existed. Its configuration was updated from this resource and it is now managed by Terraform.", name),
+		)
+	default:
+		resp.Diagnostics.AddError(
+			"Project already exists",
+			fmt.Sprintf("Project with unique name (%s) already exists. Import it, or set adopt_existing = true to take it over.", name),
 		)
 		return
 	}
@@ -210,7 +434,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.ID = types.StringValue(name)
 
 	// Now update with full configuration
-	r.updateProjectConfig(ctx, apiCtx, client, name, &plan, &resp.Diagnostics)
+	r.updateProjectConfig(ctx, apiCtx, client, name, &plan, nil, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -245,9 +469,10 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan projectResourceModel
+	var plan, state projectResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -257,7 +482,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	name := plan.ID.ValueString()
 
 	// Update project configuration
-	r.updateProjectConfig(ctx, apiCtx, client, name, &plan, &resp.Diagnostics)
+	r.updateProjectConfig(ctx, apiCtx, client, name, &plan, &state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -283,6 +508,29 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	apiCtx := context.Background()
 	name := state.ID.ValueString()
 
+	// Enforced again at apply time as a safeguard
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Project is protected from deletion",
+			fmt.Sprintf("Project %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", name),
+		)
+		return
+	}
+
+	if !state.ArchiveOnDestroyPath.IsNull() && state.ArchiveOnDestroyPath.ValueString() != "" {
+		archivePath := state.ArchiveOnDestroyPath.ValueString()
+		timeout, _ := time.ParseDuration(projectArchiveDefaultTimeout)
+		query := url.Values{}
+		query.Set("exportAll", "true")
+		if _, _, err := exportProjectArchiveToFile(ctx, r.clients, name, query, true, timeout, archivePath); err != nil {
+			resp.Diagnostics.AddError(
+				"Error archiving project before deletion",
+				fmt.Sprintf("Could not export project %s to %s, so it was not deleted: %s", name, archivePath, err.Error()),
+			)
+			return
+		}
+	}
+
 	_, err := client.ProjectDelete(apiCtx, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -298,8 +546,49 @@ func (r *projectResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
 }
 
-// Helper function to update project configuration
-func (r *projectResource) updateProjectConfig(ctx context.Context, apiCtx context.Context, client *rundeck.BaseClient, projectName string, plan *projectResourceModel, diags *diag.Diagnostics) {
+// Helper function to update project configuration. prior is the state before
+// the update, or nil when the project is being created.
+func (r *projectResource) updateProjectConfig(ctx context.Context, apiCtx context.Context, client *rundeck.BaseClient, projectName string, plan *projectResourceModel, prior *projectResourceModel, diags *diag.Diagnostics) {
+	updateMap := projectConfigUpdateMap(ctx, plan, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if plan.ConfigManagementMode.ValueString() == projectConfigModeMerge {
+		// Keys Terraform wrote before but no longer declares are removed. They
+		// are only known if the prior apply was also in merge mode; switching
+		// from authoritative mode never removes anything.
+		var removed []string
+		if prior != nil && prior.ConfigManagementMode.ValueString() == projectConfigModeMerge {
+			for k := range projectConfigUpdateMap(ctx, prior, diags) {
+				if _, ok := updateMap[k]; !ok {
+					removed = append(removed, k)
+				}
+			}
+		}
+
+		if err := mergeProjectConfigKeys(ctx, r.clients, projectName, updateMap, removed); err != nil {
+			diags.AddError(
+				"Error updating project configuration",
+				fmt.Sprintf("Could not update project configuration: %s", err.Error()),
+			)
+		}
+		return
+	}
+
+	_, err := client.ProjectConfigUpdate(apiCtx, projectName, updateMap)
+	if err != nil {
+		diags.AddError(
+			"Error updating project configuration",
+			fmt.Sprintf("Could not update project configuration: %s", err.Error()),
+		)
+		return
+	}
+}
+
+// projectConfigUpdateMap builds the project configuration declared by the
+// model: extra_config, the standard attributes and the resource model sources.
+func projectConfigUpdateMap(ctx context.Context, plan *projectResourceModel, diags *diag.Diagnostics) map[string]string {
 	updateMap := map[string]string{}
 
 	// Handle extra_config
@@ -309,7 +598,7 @@ func (r *projectResource) updateProjectConfig(ctx context.Context, apiCtx contex
 		extraConfig := make(map[string]types.String)
 		diags.Append(plan.ExtraConfig.ElementsAs(ctx, &extraConfig, false)...)
 		if diags.HasError() {
-			return
+			return nil
 		}
 		for k, v := range extraConfig {
 			// Skip keys that are standard project attributes - they're handled separately
@@ -317,7 +606,7 @@ func (r *projectResource) updateProjectConfig(ctx context.Context, apiCtx contex
 				continue
 			}
 			// Skip resource model source keys - they're handled separately
-			if strings.HasPrefix(k, "resources.source.") {
+			if strings.HasPrefix(k, projectModelSourcePrefix) {
 				continue
 			}
 			// Skip null and unknown values - Terraform treats null as "omit this attribute"
@@ -328,6 +617,29 @@ func (r *projectResource) updateProjectConfig(ctx context.Context, apiCtx contex
 		}
 	}
 
+	// Handle execution history cleanup
+	for k, v := range projectExecutionHistoryCleanupConfig(ctx, plan.ExecutionHistoryCleanup, diags) {
+		updateMap[k] = v
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	// Handle global variables
+	if !plan.GlobalVariables.IsNull() && !plan.GlobalVariables.IsUnknown() {
+		globals := make(map[string]types.String)
+		diags.Append(plan.GlobalVariables.ElementsAs(ctx, &globals, false)...)
+		if diags.HasError() {
+			return nil
+		}
+		for k, v := range globals {
+			if v.IsNull() || v.IsUnknown() {
+				continue
+			}
+			updateMap[projectGlobalsPrefix+k] = v.ValueString()
+		}
+	}
+
 	// Handle standard project attributes
 	if !plan.Description.IsNull() {
 		updateMap["project.description"] = plan.Description.ValueString()
@@ -348,45 +660,43 @@ func (r *projectResource) updateProjectConfig(ctx context.Context, apiCtx contex
 		updateMap["project.ssh-keypath"] = plan.SSHKeyFilePath.ValueString()
 	}
 
+	// Handle typed node executor and file copier blocks
+	for k, v := range projectNodeExecutorService.config(plan.NodeExecutor) {
+		updateMap[k] = v
+	}
+	for k, v := range projectFileCopierService.config(plan.FileCopier) {
+		updateMap[k] = v
+	}
+
 	// Handle resource model sources
 	var resourceModelSources []resourceModelSourceModel
 	diags.Append(plan.ResourceModelSource.ElementsAs(ctx, &resourceModelSources, false)...)
 	if diags.HasError() {
-		return
+		return nil
 	}
 
 	for i, rms := range resourceModelSources {
-		pluginType := rms.Type.ValueString()
-		attrKeyPrefix := fmt.Sprintf("resources.source.%v.", i+1)
-		typeKey := attrKeyPrefix + "type"
-		configKeyPrefix := fmt.Sprintf("%vconfig.", attrKeyPrefix)
-		updateMap[typeKey] = pluginType
-
-		if rms.Config.IsNull() || rms.Config.IsUnknown() {
-			continue
-		}
-		config := make(map[string]types.String)
-		diags.Append(rms.Config.ElementsAs(ctx, &config, false)...)
-		if diags.HasError() {
-			return
-		}
-		for k, v := range config {
-			// Skip null and unknown values - Terraform treats null as "omit this attribute"
-			if v.IsNull() || v.IsUnknown() {
-				continue
+		sourceConfig := map[string]string{}
+		if !rms.Config.IsNull() && !rms.Config.IsUnknown() {
+			config := make(map[string]types.String)
+			diags.Append(rms.Config.ElementsAs(ctx, &config, false)...)
+			if diags.HasError() {
+				return nil
+			}
+			for k, v := range config {
+				// Skip null and unknown values - Terraform treats null as "omit this attribute"
+				if v.IsNull() || v.IsUnknown() {
+					continue
+				}
+				sourceConfig[k] = v.ValueString()
 			}
-			updateMap[configKeyPrefix+k] = v.ValueString()
+		}
+		for k, v := range projectModelSourceKeys(i+1, rms.Label.ValueString(), rms.Type.ValueString(), sourceConfig) {
+			updateMap[k] = v
 		}
 	}
 
-	_, err := client.ProjectConfigUpdate(apiCtx, projectName, updateMap)
-	if err != nil {
-		diags.AddError(
-			"Error updating project configuration",
-			fmt.Sprintf("Could not update project configuration: %s", err.Error()),
-		)
-		return
-	}
+	return updateMap
 }
 
 // Helper function to read project state
@@ -433,102 +743,94 @@ func (r *projectResource) readProject(ctx context.Context, apiCtx context.Contex
 		}
 	}
 
-	// Capture null config elements the caller configured. Terraform keeps null
-	// map elements in the planned value, but the Rundeck API never stores or
-	// returns them, so they must be preserved here to avoid an "inconsistent
-	// result after apply" error and refresh drift (#248). Keyed by source number
-	// (list position + 1), matching how sources are written as resources.source.N.
-	priorNullConfigKeys := map[int][]string{}
-	if !state.ResourceModelSource.IsNull() && !state.ResourceModelSource.IsUnknown() {
-		var priorSources []resourceModelSourceModel
-		if d := state.ResourceModelSource.ElementsAs(ctx, &priorSources, false); !d.HasError() {
-			for i, ps := range priorSources {
-				if ps.Config.IsNull() || ps.Config.IsUnknown() {
-					continue
-				}
-				var nullKeys []string
-				for k, v := range ps.Config.Elements() {
-					if v.IsNull() {
-						nullKeys = append(nullKeys, k)
-					}
-				}
-				if len(nullKeys) > 0 {
-					priorNullConfigKeys[i+1] = nullKeys
-				}
-			}
-		}
+	// Read back the typed node executor and file copier blocks
+	nodeExecutor, d := projectNodeExecutorService.read(state.NodeExecutor, state.DefaultNodeExecutorPlugin.ValueString(), projectConfig)
+	diags.Append(d...)
+	fileCopier, d := projectFileCopierService.read(state.FileCopier, state.DefaultNodeFileCopierPlugin.ValueString(), projectConfig)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
 	}
+	state.NodeExecutor = nodeExecutor
+	state.FileCopier = fileCopier
 
-	// Parse resource model sources
-	resourceSourceMap := map[int]interface{}{}
-	configMaps := map[int]interface{}{}
-	for configKey, v := range projectConfig {
-		if strings.HasPrefix(configKey, "resources.source.") {
-			nameParts := strings.Split(configKey, ".")
-			if len(nameParts) < 4 {
-				continue
-			}
-
-			index, err := strconv.Atoi(nameParts[2])
-			if err != nil {
-				continue
-			}
-
-			if _, ok := resourceSourceMap[index]; !ok {
-				configMap := map[string]interface{}{}
-				configMaps[index] = configMap
-				resourceSourceMap[index] = map[string]interface{}{
-					"config": configMap,
-				}
-			}
-
-			switch nameParts[3] {
-			case "type":
-				if len(nameParts) != 4 {
-					continue
-				}
-				m := resourceSourceMap[index].(map[string]interface{})
-				m["type"] = v
-			case "config":
-				if len(nameParts) != 5 {
-					continue
-				}
-				m := configMaps[index].(map[string]interface{})
-				m[nameParts[4]] = v
+	// Read back execution history cleanup settings
+	cleanup, d := readProjectExecutionHistoryCleanup(ctx, state.ExecutionHistoryCleanup, projectConfig)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	state.ExecutionHistoryCleanup = cleanup
+
+	// Read back global variables when they are managed through
+	// global_variables; otherwise they stay in extra_config. In merge mode
+	// only the declared variables are tracked.
+	if !state.GlobalVariables.IsNull() && !state.GlobalVariables.IsUnknown() {
+		var declared map[string]bool
+		if state.ConfigManagementMode.ValueString() == projectConfigModeMerge {
+			declared = map[string]bool{}
+			for k := range state.GlobalVariables.Elements() {
+				declared[k] = true
 			}
-
-			delete(projectConfig, configKey)
 		}
+		globals := map[string]attr.Value{}
+		for k, v := range takeProjectGlobals(projectConfig, declared) {
+			globals[k] = types.StringValue(v)
+		}
+		globalsMap, d := types.MapValue(types.StringType, globals)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+		state.GlobalVariables = globalsMap
 	}
 
-	// Convert resource model sources to list
-	resourceSourceIndices := []int{}
-	for k := range resourceSourceMap {
-		resourceSourceIndices = append(resourceSourceIndices, k)
+	// Capture the prior blocks. Their labels are used to match sources when
+	// the server renumbers them, and null config elements the caller
+	// configured must be preserved: Terraform keeps null map elements in the
+	// planned value, but the Rundeck API never stores or returns them, so
+	// they are kept here to avoid an "inconsistent result after apply" error
+	// and refresh drift (#248).
+	var priorSources []resourceModelSourceModel
+	if !state.ResourceModelSource.IsNull() && !state.ResourceModelSource.IsUnknown() {
+		if d := state.ResourceModelSource.ElementsAs(ctx, &priorSources, false); d.HasError() {
+			priorSources = nil
+		}
+	}
+	priorLabels := make([]string, len(priorSources))
+	for i, ps := range priorSources {
+		priorLabels[i] = ps.Label.ValueString()
 	}
-	sort.Ints(resourceSourceIndices)
 
-	resourceModelSourceElements := []attr.Value{}
-	for _, index := range resourceSourceIndices {
-		source := resourceSourceMap[index].(map[string]interface{})
+	// Parse resource model sources, ordered like the prior blocks
+	sources, priorRefs := matchProjectModelSources(takeProjectModelSources(projectConfig), priorLabels)
 
+	resourceModelSourceElements := []attr.Value{}
+	for i, source := range sources {
 		configMap := make(map[string]attr.Value)
-		if configInterface, ok := source["config"]; ok {
-			for k, v := range configInterface.(map[string]interface{}) {
-				configMap[k] = types.StringValue(v.(string))
-			}
+		for k, v := range source.Config {
+			configMap[k] = types.StringValue(v)
 		}
 
 		// Preserve user-configured null elements that the API does not round-trip,
 		// so the config map matches the planned value (#248).
-		for _, k := range priorNullConfigKeys[index] {
-			if _, exists := configMap[k]; !exists {
-				configMap[k] = types.StringNull()
+		if ref := priorRefs[i]; ref >= 0 {
+			priorConfig := priorSources[ref].Config
+			if !priorConfig.IsNull() && !priorConfig.IsUnknown() {
+				for k, v := range priorConfig.Elements() {
+					if _, exists := configMap[k]; !exists && v.IsNull() {
+						configMap[k] = types.StringNull()
+					}
+				}
 			}
 		}
 
 		sourceModel := resourceModelSourceModel{
-			Type: types.StringValue(source["type"].(string)),
+			Type:  types.StringValue(source.Type),
+			Label: types.StringNull(),
+		}
+		if source.Label != "" {
+			sourceModel.Label = types.StringValue(source.Label)
 		}
 
 		// Only set Config if there are actual config values
@@ -539,14 +841,7 @@ func (r *projectResource) readProject(ctx context.Context, apiCtx context.Contex
 			sourceModel.Config = types.MapValueMust(types.StringType, configMap)
 		}
 
-		objType := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"type":   types.StringType,
-				"config": types.MapType{ElemType: types.StringType},
-			},
-		}
-
-		objVal, diagsObj := types.ObjectValueFrom(ctx, objType.AttrTypes, sourceModel)
+		objVal, diagsObj := types.ObjectValueFrom(ctx, resourceModelSourceAttrTypes(), sourceModel)
 		diags.Append(diagsObj...)
 		if diags.HasError() {
 			return
@@ -555,25 +850,34 @@ func (r *projectResource) readProject(ctx context.Context, apiCtx context.Contex
 		resourceModelSourceElements = append(resourceModelSourceElements, objVal)
 	}
 
-	listType := types.ListType{
-		ElemType: types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"type":   types.StringType,
-				"config": types.MapType{ElemType: types.StringType},
-			},
-		},
-	}
-
-	listVal, diagsList := types.ListValue(listType.ElemType, resourceModelSourceElements)
+	listVal, diagsList := types.ListValue(types.ObjectType{AttrTypes: resourceModelSourceAttrTypes()}, resourceModelSourceElements)
 	diags.Append(diagsList...)
 	if diags.HasError() {
 		return
 	}
 	state.ResourceModelSource = listVal
 
-	// Handle extra_config
+	// Handle extra_config. In merge mode only the declared keys are tracked, so
+	// keys set in the GUI or by plugins don't show up as drift.
+	if state.ConfigManagementMode.IsNull() || state.ConfigManagementMode.IsUnknown() {
+		state.ConfigManagementMode = types.StringValue(projectConfigModeAuthoritative)
+	}
+	if state.DeletionProtection.IsNull() || state.DeletionProtection.IsUnknown() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.AdoptExisting.IsNull() || state.AdoptExisting.IsUnknown() {
+		state.AdoptExisting = types.BoolValue(false)
+	}
+	mergeMode := state.ConfigManagementMode.ValueString() == projectConfigModeMerge
+	declaredExtraConfig := map[string]attr.Value{}
+	if !state.ExtraConfig.IsNull() && !state.ExtraConfig.IsUnknown() {
+		declaredExtraConfig = state.ExtraConfig.Elements()
+	}
 	extraConfig := map[string]attr.Value{}
 	for k, v := range projectConfig {
+		if _, declared := declaredExtraConfig[k]; mergeMode && !declared {
+			continue
+		}
 		extraConfig[k] = types.StringValue(v.(string))
 	}
 	extraConfigMap, diagsMap := types.MapValue(types.StringType, extraConfig)
@@ -0,0 +1,240 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectGlobalVariableResource{}
+	_ resource.ResourceWithConfigure   = &projectGlobalVariableResource{}
+	_ resource.ResourceWithImportState = &projectGlobalVariableResource{}
+)
+
+// NewProjectGlobalVariableResource is a helper function to simplify the provider implementation.
+func NewProjectGlobalVariableResource() resource.Resource {
+	return &projectGlobalVariableResource{}
+}
+
+// projectGlobalVariableResource is the resource implementation.
+type projectGlobalVariableResource struct {
+	clients *RundeckClients
+}
+
+// projectGlobalVariableResourceModel describes the resource data model.
+type projectGlobalVariableResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Project types.String `tfsdk:"project"`
+	Name    types.String `tfsdk:"name"`
+	Value   types.String `tfsdk:"value"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectGlobalVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_global_variable"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectGlobalVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single project global variable (project.globals.<name>) without touching the project's other properties.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the variable in the format project/name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the variable, referenced in jobs as ${globals.<name>}.",
+				Required:    true,
+				Validators: []validator.String{
+					projectGlobalVariableNameValidator,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the variable.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectGlobalVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectGlobalVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectGlobalVariableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	name := plan.Name.ValueString()
+
+	if err := putProjectConfigKey(ctx, r.clients, project, projectGlobalsPrefix+name, plan.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project global variable",
+			fmt.Sprintf("Could not set global variable %s in project %s: %s", name, project, err.Error()),
+		)
+		return
+	}
+
+	// Set the ID
+	plan.ID = types.StringValue(projectGlobalVariableID(project, name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectGlobalVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectGlobalVariableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	name := state.Name.ValueString()
+
+	config, status, err := getProjectConfig(ctx, r.clients, project)
+	if err != nil {
+		if status == 404 {
+			// Project no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading project global variable",
+			fmt.Sprintf("Could not read configuration of project %s: %s", project, err.Error()),
+		)
+		return
+	}
+
+	value, ok := config[projectGlobalsPrefix+name]
+	if !ok {
+		// Variable was removed outside Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update the state
+	state.Value = types.StringValue(value)
+	state.ID = types.StringValue(projectGlobalVariableID(project, name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectGlobalVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectGlobalVariableResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	name := plan.Name.ValueString()
+
+	if err := putProjectConfigKey(ctx, r.clients, project, projectGlobalsPrefix+name, plan.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project global variable",
+			fmt.Sprintf("Could not set global variable %s in project %s: %s", name, project, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(projectGlobalVariableID(project, name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectGlobalVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectGlobalVariableResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	name := state.Name.ValueString()
+
+	if err := deleteProjectConfigKey(ctx, r.clients, project, projectGlobalsPrefix+name); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project global variable",
+			fmt.Sprintf("Could not remove global variable %s from project %s: %s", name, project, err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *projectGlobalVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import identifier in format 'project/name', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// projectGlobalVariableID builds the composite resource ID for a project global variable.
+func projectGlobalVariableID(project, name string) string {
+	return project + "/" + name
+}
@@ -0,0 +1,68 @@
+package rundeck
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectGlobalVariable_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectConfigKeyCheckDestroy("terraform-acc-test-global-variable", "project.globals.tier"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectGlobalVariableConfig, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_project_global_variable.tier", "id", "terraform-acc-test-global-variable/tier"),
+					resource.TestCheckResourceAttr("rundeck_project.test", "global_variables.%", "1"),
+					testAccProjectConfigKeyCheckValue("terraform-acc-test-global-variable", "project.globals.region", "eu-west-1"),
+					testAccProjectConfigKeyCheckValue("terraform-acc-test-global-variable", "project.globals.tier", "staging"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectGlobalVariableConfig, "production"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_project.test", "global_variables.%", "1"),
+					testAccProjectConfigKeyCheckValue("terraform-acc-test-global-variable", "project.globals.region", "eu-west-1"),
+					testAccProjectConfigKeyCheckValue("terraform-acc-test-global-variable", "project.globals.tier", "production"),
+				),
+			},
+			// The layered variable must not show up as drift on the project
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+			},
+			{
+				ResourceName:      "rundeck_project_global_variable.tier",
+				ImportState:       true,
+				ImportStateId:     "terraform-acc-test-global-variable/tier",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccProjectGlobalVariableConfig = `
+resource "rundeck_project" "test" {
+  name                   = "terraform-acc-test-global-variable"
+  description            = "parent project for global variable acceptance tests"
+  config_management_mode = "merge"
+
+  global_variables = {
+    region = "eu-west-1"
+  }
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_project_global_variable" "tier" {
+  project = rundeck_project.test.name
+  name    = "tier"
+  value   = "%s"
+}
+`
@@ -0,0 +1,410 @@
+package rundeck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &projectNodeResource{}
+	_ resource.ResourceWithConfigure      = &projectNodeResource{}
+	_ resource.ResourceWithImportState    = &projectNodeResource{}
+	_ resource.ResourceWithValidateConfig = &projectNodeResource{}
+)
+
+// NewProjectNodeResource is a helper function to simplify the provider implementation.
+func NewProjectNodeResource() resource.Resource {
+	return &projectNodeResource{}
+}
+
+// projectNodeResource is the resource implementation.
+type projectNodeResource struct {
+	clients *RundeckClients
+}
+
+// projectNodeResourceModel describes the resource data model.
+type projectNodeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Project     types.String `tfsdk:"project"`
+	SourceIndex types.Int64  `tfsdk:"source_index"`
+	Name        types.String `tfsdk:"name"`
+	Hostname    types.String `tfsdk:"hostname"`
+	Username    types.String `tfsdk:"username"`
+	OsFamily    types.String `tfsdk:"os_family"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Set    `tfsdk:"tags"`
+	Attributes  types.Map    `tfsdk:"attributes"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectNodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_node"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectNodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single node definition in a writable resource model source of a Rundeck project. Other nodes in the source are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the node in the format project/source_index/name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_index": schema.Int64Attribute{
+				Description: "1-based index of the writable resource model source, in the order of the project's resource_model_source blocks.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Node name (nodename). Must be unique within the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Hostname or IP address used to connect to the node, optionally with a port.",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "User name used to connect to the node.",
+				Optional:    true,
+			},
+			"os_family": schema.StringAttribute{
+				Description: "Operating system family, e.g. unix or windows.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the node.",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tags of the node.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"attributes": schema.MapAttribute{
+				Description: "Additional node attributes. Must not contain nodename, hostname, username, tags, osFamily or description.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectNodeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// ValidateConfig rejects custom attributes that shadow the dedicated fields.
+func (r *projectNodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var attributes types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("attributes"), &attributes)...)
+	if resp.Diagnostics.HasError() || attributes.IsNull() || attributes.IsUnknown() {
+		return
+	}
+
+	for key := range attributes.Elements() {
+		if nodeReservedAttributes[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attributes").AtMapKey(key),
+				"Reserved node attribute",
+				fmt.Sprintf("%q has its own argument on rundeck_project_node and cannot be set in attributes.", key),
+			)
+		}
+	}
+}
+
+// Create adds the node to the source and sets the initial Terraform state.
+func (r *projectNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectNodeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	index := plan.SourceIndex.ValueInt64()
+	name := plan.Name.ValueString()
+
+	node, err := r.nodeAttributes(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating project node", err.Error())
+		return
+	}
+
+	err = updateNodeSource(ctx, r.clients, project, index, func(nodes map[string]map[string]interface{}) error {
+		if _, exists := nodes[name]; exists {
+			return fmt.Errorf("node %s already exists in source %d; import it with terraform import", name, index)
+		}
+		nodes[name] = node
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project node",
+			fmt.Sprintf("Could not add node %s to source %d of project %s: %s", name, index, project, err.Error()),
+		)
+		return
+	}
+
+	// Set the ID
+	plan.ID = types.StringValue(projectNodeID(project, index, name))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectNodeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	index := state.SourceIndex.ValueInt64()
+	name := state.Name.ValueString()
+
+	doc, err := getNodeSource(ctx, r.clients, project, index)
+	if err != nil {
+		var notFound *NotFoundError
+		if errors.As(err, &notFound) {
+			// Source or project no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading project node",
+			fmt.Sprintf("Could not read source %d of project %s: %s", index, project, err.Error()),
+		)
+		return
+	}
+
+	node, ok := doc.Nodes[name]
+	if !ok {
+		// Node no longer exists, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Hostname = nodeStringAttribute(node, "hostname")
+	state.Username = nodeStringAttribute(node, "username")
+	state.OsFamily = nodeStringAttribute(node, "osFamily")
+	state.Description = nodeStringAttribute(node, "description")
+
+	if tags := nodeTags(node["tags"]); len(tags) > 0 || !state.Tags.IsNull() {
+		if tags == nil {
+			tags = []string{}
+		}
+		tagSet, diags := types.SetValueFrom(ctx, types.StringType, tags)
+		resp.Diagnostics.Append(diags...)
+		state.Tags = tagSet
+	}
+
+	attributes := map[string]string{}
+	for key, value := range node {
+		if !nodeReservedAttributes[key] {
+			attributes[key] = fmt.Sprint(value)
+		}
+	}
+	if len(attributes) > 0 || !state.Attributes.IsNull() {
+		attributeMap, diags := types.MapValueFrom(ctx, types.StringType, attributes)
+		resp.Diagnostics.Append(diags...)
+		state.Attributes = attributeMap
+	}
+
+	state.ID = types.StringValue(projectNodeID(project, index, name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the node entry and sets the updated Terraform state on success.
+func (r *projectNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectNodeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	index := plan.SourceIndex.ValueInt64()
+	name := plan.Name.ValueString()
+
+	node, err := r.nodeAttributes(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating project node", err.Error())
+		return
+	}
+
+	err = updateNodeSource(ctx, r.clients, project, index, func(nodes map[string]map[string]interface{}) error {
+		nodes[name] = node
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project node",
+			fmt.Sprintf("Could not update node %s in source %d of project %s: %s", name, index, project, err.Error()),
+		)
+		return
+	}
+
+	// Ensure ID is set
+	plan.ID = types.StringValue(projectNodeID(project, index, name))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the node from the source and removes the Terraform state on success.
+func (r *projectNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectNodeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	index := state.SourceIndex.ValueInt64()
+	name := state.Name.ValueString()
+
+	err := updateNodeSource(ctx, r.clients, project, index, func(nodes map[string]map[string]interface{}) error {
+		delete(nodes, name)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project node",
+			fmt.Sprintf("Could not remove node %s from source %d of project %s: %s", name, index, project, err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *projectNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	var index int64
+	var err error
+	if len(parts) == 3 {
+		index, err = strconv.ParseInt(parts[1], 10, 64)
+	}
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || err != nil || index < 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import identifier in format 'project/source_index/name', got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_index"), index)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+// nodeAttributes builds the node entry written to the source.
+func (r *projectNodeResource) nodeAttributes(ctx context.Context, model *projectNodeResourceModel) (map[string]interface{}, error) {
+	node := map[string]interface{}{
+		"nodename": model.Name.ValueString(),
+		"hostname": model.Hostname.ValueString(),
+	}
+	if !model.Username.IsNull() {
+		node["username"] = model.Username.ValueString()
+	}
+	if !model.OsFamily.IsNull() {
+		node["osFamily"] = model.OsFamily.ValueString()
+	}
+	if !model.Description.IsNull() {
+		node["description"] = model.Description.ValueString()
+	}
+
+	if !model.Tags.IsNull() {
+		var tags []string
+		if diags := model.Tags.ElementsAs(ctx, &tags, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read tags")
+		}
+		sort.Strings(tags)
+		node["tags"] = strings.Join(tags, ",")
+	}
+
+	if !model.Attributes.IsNull() {
+		var attributes map[string]string
+		if diags := model.Attributes.ElementsAs(ctx, &attributes, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read attributes")
+		}
+		for key, value := range attributes {
+			node[key] = value
+		}
+	}
+
+	return node, nil
+}
+
+// nodeStringAttribute returns a node attribute as a string, or null if unset.
+func nodeStringAttribute(node map[string]interface{}, key string) types.String {
+	value, ok := node[key]
+	if !ok || value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(fmt.Sprint(value))
+}
+
+// projectNodeID builds the composite resource ID for a project node.
+func projectNodeID(project string, index int64, name string) string {
+	return fmt.Sprintf("%s/%d/%s", project, index, name)
+}
@@ -0,0 +1,129 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectNode_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectNodeCheckDestroy("terraform-acc-test-project-node", "web1", "web2"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectNodeConfig_basic, "10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectNodeCheckExists("terraform-acc-test-project-node", "web1", "10.0.0.1"),
+					testAccProjectNodeCheckExists("terraform-acc-test-project-node", "web2", "10.0.0.2"),
+					resource.TestCheckResourceAttr("rundeck_project_node.web1", "id", "terraform-acc-test-project-node/1/web1"),
+					resource.TestCheckResourceAttr("rundeck_project_node.web1", "tags.#", "2"),
+					resource.TestCheckResourceAttr("rundeck_project_node.web1", "attributes.datacenter", "east"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectNodeConfig_basic, "10.0.0.11"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectNodeCheckExists("terraform-acc-test-project-node", "web1", "10.0.0.11"),
+					testAccProjectNodeCheckExists("terraform-acc-test-project-node", "web2", "10.0.0.2"),
+				),
+			},
+			{
+				ResourceName:      "rundeck_project_node.web1",
+				ImportStateId:     "terraform-acc-test-project-node/1/web1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectNodeCheckExists(project, name, hostname string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		doc, err := getNodeSource(context.Background(), clients, project, 1)
+		if err != nil {
+			return fmt.Errorf("Error reading node source: %s", err)
+		}
+
+		node, ok := doc.Nodes[name]
+		if !ok {
+			return fmt.Errorf("node %s not found in source", name)
+		}
+		if got := fmt.Sprint(node["hostname"]); got != hostname {
+			return fmt.Errorf("node %s hostname: expected %s, got %s", name, hostname, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectNodeCheckDestroy(project string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		doc, err := getNodeSource(context.Background(), clients, project, 1)
+		if err != nil {
+			// The project itself was destroyed
+			return nil
+		}
+		for _, name := range names {
+			if _, ok := doc.Nodes[name]; ok {
+				return fmt.Errorf("node %s still exists", name)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccProjectNodeConfig_basic = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-project-node"
+  description = "parent project for node acceptance tests"
+
+  resource_model_source {
+    type = "file"
+    config = {
+      format                    = "resourceyaml"
+      file                      = "/tmp/terraform-acc-test-project-node.yaml"
+      writeable                 = "true"
+      generateFileAutomatically = "true"
+    }
+  }
+}
+
+resource "rundeck_project_node" "web1" {
+  project      = rundeck_project.test.name
+  source_index = 1
+  name         = "web1"
+  hostname     = "%s"
+  username     = "deploy"
+  os_family    = "unix"
+  tags         = ["web", "linux"]
+
+  attributes = {
+    datacenter = "east"
+  }
+}
+
+resource "rundeck_project_node" "web2" {
+  project      = rundeck_project.test.name
+  source_index = 1
+  name         = "web2"
+  hostname     = "10.0.0.2"
+}
+`
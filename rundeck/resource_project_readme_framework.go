@@ -0,0 +1,238 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectFileResource{}
+	_ resource.ResourceWithConfigure   = &projectFileResource{}
+	_ resource.ResourceWithImportState = &projectFileResource{}
+)
+
+// NewProjectReadmeResource manages a project's readme.md.
+func NewProjectReadmeResource() resource.Resource {
+	return &projectFileResource{
+		typeName: "_project_readme",
+		fileName: "readme.md",
+		label:    "README",
+	}
+}
+
+// NewProjectMotdResource manages a project's motd.md.
+func NewProjectMotdResource() resource.Resource {
+	return &projectFileResource{
+		typeName: "_project_motd",
+		fileName: "motd.md",
+		label:    "MOTD",
+	}
+}
+
+// projectFileResource is the resource implementation shared by the project
+// README and MOTD resources, which differ only in the file they manage.
+type projectFileResource struct {
+	clients  *RundeckClients
+	typeName string
+	fileName string
+	label    string
+}
+
+// projectFileResourceModel describes the resource data model.
+type projectFileResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Project types.String `tfsdk:"project"`
+	Content types.String `tfsdk:"content"`
+}
+
+// projectFileContents is the JSON wrapper Rundeck uses for project file text.
+type projectFileContents struct {
+	Contents string `json:"contents"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *projectFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Manages the %s (%s) of a Rundeck project.", r.label, r.fileName),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: fmt.Sprintf("Markdown content of the project %s.", r.label),
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	if err := r.put(ctx, project, plan.Content.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error creating project %s", r.label),
+			fmt.Sprintf("Could not write %s of project %s: %s", r.fileName, project, err.Error()),
+		)
+		return
+	}
+
+	// Set the ID
+	plan.ID = types.StringValue(project)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+
+	var contents projectFileContents
+	status, err := rundeckAPIRequest(ctx, r.clients, http.MethodGet, r.filePath(project), nil, nil, &contents)
+	if err != nil {
+		if status == 404 {
+			// File (or project) no longer exists, remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading project %s", r.label),
+			fmt.Sprintf("Could not read %s of project %s: %s", r.fileName, project, err.Error()),
+		)
+		return
+	}
+
+	// Update the state
+	state.Content = types.StringValue(contents.Contents)
+	state.ID = types.StringValue(project)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectFileResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := plan.Project.ValueString()
+	if err := r.put(ctx, project, plan.Content.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating project %s", r.label),
+			fmt.Sprintf("Could not write %s of project %s: %s", r.fileName, project, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(project)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectFileResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	status, err := rundeckAPIRequest(ctx, r.clients, http.MethodDelete, r.filePath(project), nil, nil, nil)
+	if err != nil {
+		if status == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting project %s", r.label),
+			fmt.Sprintf("Could not delete %s of project %s: %s", r.fileName, project, err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state using the project name.
+func (r *projectFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), req.ID)...)
+}
+
+func (r *projectFileResource) filePath(project string) string {
+	return fmt.Sprintf("project/%s/%s", url.PathEscape(project), r.fileName)
+}
+
+// put writes the file content. Rundeck accepts the text wrapped in a
+// {"contents": ...} document when the content type is application/json.
+func (r *projectFileResource) put(ctx context.Context, project, content string) error {
+	_, err := rundeckAPIRequest(ctx, r.clients, http.MethodPut, r.filePath(project), nil, projectFileContents{Contents: content}, nil)
+	return err
+}
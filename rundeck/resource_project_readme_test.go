@@ -0,0 +1,112 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectReadmeMotd_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectFileCheckDestroy("terraform-acc-test-project-readme", "readme.md", "motd.md"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectReadmeMotdConfig, "See the runbook."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectFileCheckContent("terraform-acc-test-project-readme", "readme.md", "# Runbooks\n\nSee the runbook.\n"),
+					testAccProjectFileCheckContent("terraform-acc-test-project-readme", "motd.md", "On call: platform team"),
+					resource.TestCheckResourceAttr("rundeck_project_readme.test", "id", "terraform-acc-test-project-readme"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectReadmeMotdConfig, "See the updated runbook."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectFileCheckContent("terraform-acc-test-project-readme", "readme.md", "# Runbooks\n\nSee the updated runbook.\n"),
+				),
+			},
+			{
+				ResourceName:      "rundeck_project_readme.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "rundeck_project_motd.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectFileCheckContent(project, fileName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		var contents projectFileContents
+		if _, err := rundeckAPIRequest(context.Background(), clients, http.MethodGet, fmt.Sprintf("project/%s/%s", project, fileName), nil, nil, &contents); err != nil {
+			return fmt.Errorf("Error reading %s: %s", fileName, err)
+		}
+		if contents.Contents != expected {
+			return fmt.Errorf("%s: expected %q, got %q", fileName, expected, contents.Contents)
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectFileCheckDestroy(project string, fileNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		for _, fileName := range fileNames {
+			status, err := rundeckAPIRequest(context.Background(), clients, http.MethodGet, fmt.Sprintf("project/%s/%s", project, fileName), nil, nil, nil)
+			if err == nil {
+				return fmt.Errorf("%s of project %s still exists", fileName, project)
+			}
+			if status != 404 {
+				return fmt.Errorf("unexpected error checking %s: %s", fileName, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+const testAccProjectReadmeMotdConfig = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-project-readme"
+  description = "parent project for readme acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_project_readme" "test" {
+  project = rundeck_project.test.name
+  content = <<-EOT
+    # Runbooks
+
+    %s
+  EOT
+}
+
+resource "rundeck_project_motd" "test" {
+  project = rundeck_project.test.name
+  content = "On call: platform team"
+}
+`
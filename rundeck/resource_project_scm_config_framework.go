@@ -0,0 +1,423 @@
+package rundeck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openapi "github.com/rundeck/go-rundeck/rundeck-v2"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectScmConfigResource{}
+	_ resource.ResourceWithConfigure   = &projectScmConfigResource{}
+	_ resource.ResourceWithImportState = &projectScmConfigResource{}
+)
+
+// NewProjectScmConfigResource is a helper function to simplify the provider implementation.
+func NewProjectScmConfigResource() resource.Resource {
+	return &projectScmConfigResource{}
+}
+
+// projectScmConfigResource is the resource implementation.
+type projectScmConfigResource struct {
+	clients *RundeckClients
+}
+
+// projectScmConfigResourceModel describes the resource data model.
+type projectScmConfigResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Project       types.String `tfsdk:"project"`
+	Integration   types.String `tfsdk:"integration"`
+	PluginType    types.String `tfsdk:"plugin_type"`
+	Config        types.Map    `tfsdk:"config"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	SyncState     types.String `tfsdk:"sync_state"`
+	StatusMessage types.String `tfsdk:"status_message"`
+}
+
+// Metadata returns the resource type name.
+func (r *projectScmConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_scm_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectScmConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the SCM import or export plugin configuration of a Rundeck project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the SCM configuration in the format project/integration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "Name of the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration": schema.StringAttribute{
+				Description: "SCM integration to configure: import or export.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("import", "export"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plugin_type": schema.StringAttribute{
+				Description: "SCM plugin type, e.g. git-import or git-export.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "Plugin configuration properties, as listed by the plugin's setup input fields.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the plugin is enabled. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"sync_state": schema.StringAttribute{
+				Description: "Synchronization state reported by the plugin, e.g. CLEAN or EXPORT_NEEDED.",
+				Computed:    true,
+			},
+			"status_message": schema.StringAttribute{
+				Description: "Status message reported by the plugin.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectScmConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectScmConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectScmConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setup also enables the plugin
+	r.setup(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.Enabled.ValueBool() {
+		r.toggle(ctx, &plan, false, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set the ID
+	plan.ID = types.StringValue(projectScmConfigID(plan.Project.ValueString(), plan.Integration.ValueString()))
+
+	r.readStatus(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectScmConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state projectScmConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := state.Project.ValueString()
+	integration := state.Integration.ValueString()
+
+	scmConfig, httpResp, err := r.clients.V2.SCMAPI.ApiProjectConfig(r.clients.ctx, project, integration).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			// Not configured (or project deleted), remove from state
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading project SCM configuration",
+			fmt.Sprintf("Could not read SCM %s configuration of project %s: %s", integration, project, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+
+	state.PluginType = types.StringValue(scmConfig.GetType())
+	state.Enabled = types.BoolValue(scmConfig.GetEnabled())
+
+	// Rundeck returns every plugin property, including defaults that were not
+	// configured. Only configured keys are tracked so defaults don't show up as
+	// drift; after import (no prior config) all returned keys are used.
+	var priorConfig map[string]string
+	if !state.Config.IsNull() && !state.Config.IsUnknown() {
+		resp.Diagnostics.Append(state.Config.ElementsAs(ctx, &priorConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	config := map[string]attr.Value{}
+	for k, v := range trackedScmConfig(scmConfig.GetConfig(), priorConfig, state.Config.IsNull()) {
+		config[k] = types.StringValue(v)
+	}
+	state.Config = types.MapValueMust(types.StringType, config)
+	state.ID = types.StringValue(projectScmConfigID(project, integration))
+
+	r.readStatus(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *projectScmConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state projectScmConfigResourceModel
+
+	// Read Terraform plan and state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Config.Equal(state.Config) {
+		// Running setup again replaces the configuration and enables the plugin
+		r.setup(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !plan.Enabled.ValueBool() {
+			r.toggle(ctx, &plan, false, &resp.Diagnostics)
+		}
+	} else if !plan.Enabled.Equal(state.Enabled) {
+		r.toggle(ctx, &plan, plan.Enabled.ValueBool(), &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(projectScmConfigID(plan.Project.ValueString(), plan.Integration.ValueString()))
+
+	r.readStatus(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete disables the plugin. Rundeck has no endpoint to remove a plugin
+// configuration, so the disabled configuration is left in the project.
+func (r *projectScmConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectScmConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.toggle(ctx, &state, false, &resp.Diagnostics)
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *projectScmConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || (parts[1] != "import" && parts[1] != "export") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import identifier in format 'project/integration' with integration import or export, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration"), parts[1])...)
+}
+
+// setup sends the plugin configuration to Rundeck.
+func (r *projectScmConfigResource) setup(ctx context.Context, plan *projectScmConfigResourceModel, diags *diag.Diagnostics) {
+	project := plan.Project.ValueString()
+	integration := plan.Integration.ValueString()
+	pluginType := plan.PluginType.ValueString()
+
+	config := map[string]string{}
+	diags.Append(plan.Config.ElementsAs(ctx, &config, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	result, httpResp, err := r.clients.V2.SCMAPI.ApiProjectSetup(r.clients.ctx, project, integration, pluginType).
+		Body(map[string]interface{}{"config": config}).
+		Execute()
+	if err != nil {
+		detail := openAPIErrorDetail(err, httpResp)
+		var apiErr *openapi.GenericOpenAPIError
+		if errors.As(err, &apiErr) {
+			if failed, ok := apiErr.Model().(openapi.ScmActionResult); ok {
+				detail = scmActionResultDetail(&failed)
+			}
+		}
+		diags.AddAttributeError(
+			path.Root("config"),
+			"Error configuring project SCM plugin",
+			fmt.Sprintf("Could not set up %s plugin %s for project %s: %s", integration, pluginType, project, detail),
+		)
+		return
+	}
+	if result != nil && !result.GetSuccess() {
+		diags.AddAttributeError(
+			path.Root("config"),
+			"Error configuring project SCM plugin",
+			fmt.Sprintf("Could not set up %s plugin %s for project %s: %s", integration, pluginType, project, scmActionResultDetail(result)),
+		)
+	}
+}
+
+// toggle enables or disables the plugin.
+func (r *projectScmConfigResource) toggle(ctx context.Context, model *projectScmConfigResourceModel, enable bool, diags *diag.Diagnostics) {
+	project := model.Project.ValueString()
+	integration := model.Integration.ValueString()
+	pluginType := model.PluginType.ValueString()
+
+	var httpResp *http.Response
+	var err error
+	action := "disable"
+	if enable {
+		action = "enable"
+		_, httpResp, err = r.clients.V2.SCMAPI.ApiProjectEnable(r.clients.ctx, project, integration, pluginType).Execute()
+	} else {
+		_, httpResp, err = r.clients.V2.SCMAPI.ApiProjectDisable(r.clients.ctx, project, integration, pluginType).Execute()
+	}
+	if err != nil {
+		if !enable && httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		diags.AddError(
+			"Error updating project SCM plugin",
+			fmt.Sprintf("Could not %s %s plugin %s for project %s: %s", action, integration, pluginType, project, openAPIErrorDetail(err, httpResp)),
+		)
+	}
+}
+
+// readStatus sets the computed synchronization state of the plugin. A
+// disabled plugin has no status.
+func (r *projectScmConfigResource) readStatus(ctx context.Context, model *projectScmConfigResourceModel, diags *diag.Diagnostics) {
+	model.SyncState = types.StringNull()
+	model.StatusMessage = types.StringNull()
+	if !model.Enabled.ValueBool() {
+		return
+	}
+
+	project := model.Project.ValueString()
+	integration := model.Integration.ValueString()
+
+	status, httpResp, err := r.clients.V2.SCMAPI.ApiProjectStatus(r.clients.ctx, project, integration).Execute()
+	if err != nil {
+		diags.AddError(
+			"Error reading project SCM status",
+			fmt.Sprintf("Could not read SCM %s status of project %s: %s", integration, project, openAPIErrorDetail(err, httpResp)),
+		)
+		return
+	}
+
+	if status.SynchState != nil {
+		model.SyncState = types.StringValue(status.GetSynchState())
+	}
+	if status.Message != nil {
+		model.StatusMessage = types.StringValue(status.GetMessage())
+	}
+}
+
+// scmActionResultDetail formats a failed SCM action, listing each field
+// validation error.
+func scmActionResultDetail(result *openapi.ScmActionResult) string {
+	detail := result.GetMessage()
+	validationErrors := result.GetValidationErrors()
+	if len(validationErrors) == 0 {
+		return detail
+	}
+
+	fields := make([]string, 0, len(validationErrors))
+	for field := range validationErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		detail += fmt.Sprintf("\n  - %s: %s", field, validationErrors[field])
+	}
+	return detail
+}
+
+// trackedScmConfig returns the plugin properties to keep in state: the
+// previously configured ones, or every property after an import.
+func trackedScmConfig(server, prior map[string]string, imported bool) map[string]string {
+	tracked := map[string]string{}
+	for k, v := range server {
+		if _, ok := prior[k]; ok || imported {
+			tracked[k] = v
+		}
+	}
+	return tracked
+}
+
+// projectScmConfigID builds the composite resource ID for a project SCM configuration.
+func projectScmConfigID(project, integration string) string {
+	return project + "/" + integration
+}
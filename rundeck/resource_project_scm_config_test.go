@@ -0,0 +1,115 @@
+package rundeck
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	openapi "github.com/rundeck/go-rundeck/rundeck-v2"
+)
+
+func TestAccProjectScmConfig_export(t *testing.T) {
+	// Git export needs a repository the Rundeck server can clone and push to
+	repoURL := os.Getenv("RUNDECK_SCM_GIT_URL")
+	if repoURL == "" {
+		t.Skip("SCM git export test needs a writable repository - set RUNDECK_SCM_GIT_URL")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectScmConfigConfig_export, repoURL, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_project_scm_config.export", "id", "terraform-acc-test-project-scm/export"),
+					resource.TestCheckResourceAttr("rundeck_project_scm_config.export", "enabled", "true"),
+					resource.TestCheckResourceAttr("rundeck_project_scm_config.export", "config.branch", "main"),
+					resource.TestCheckResourceAttrSet("rundeck_project_scm_config.export", "sync_state"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectScmConfigConfig_export, repoURL, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_project_scm_config.export", "enabled", "false"),
+					resource.TestCheckNoResourceAttr("rundeck_project_scm_config.export", "sync_state"),
+				),
+			},
+			{
+				ResourceName:      "rundeck_project_scm_config.export",
+				ImportState:       true,
+				ImportStateId:     "terraform-acc-test-project-scm/export",
+				ImportStateVerify: true,
+				// Import reads back every plugin property, including defaults
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+		},
+	})
+}
+
+func TestTrackedScmConfig(t *testing.T) {
+	server := map[string]string{"url": "git@example.com:repo.git", "branch": "main", "format": "xml"}
+
+	if got := trackedScmConfig(server, map[string]string{"url": "old"}, false); !reflect.DeepEqual(got, map[string]string{"url": "git@example.com:repo.git"}) {
+		t.Errorf("unexpected tracked config: %v", got)
+	}
+	// An explicitly empty config tracks nothing
+	if got := trackedScmConfig(server, map[string]string{}, false); len(got) != 0 {
+		t.Errorf("expected no tracked keys, got %v", got)
+	}
+	if got := trackedScmConfig(server, nil, true); !reflect.DeepEqual(got, server) {
+		t.Errorf("expected every key after import, got %v", got)
+	}
+}
+
+func TestScmActionResultDetail(t *testing.T) {
+	message := "Some input values were not valid."
+	result := openapi.ScmActionResult{
+		Message: &message,
+		ValidationErrors: &map[string]string{
+			"url": "required",
+			"dir": "must be a directory",
+		},
+	}
+
+	expected := "Some input values were not valid.\n  - dir: must be a directory\n  - url: required"
+	if got := scmActionResultDetail(&result); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	result.ValidationErrors = nil
+	if got := scmActionResultDetail(&result); got != message {
+		t.Errorf("expected %q, got %q", message, got)
+	}
+}
+
+const testAccProjectScmConfigConfig_export = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-project-scm"
+  description = "parent project for SCM acceptance tests"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+
+resource "rundeck_project_scm_config" "export" {
+  project     = rundeck_project.test.name
+  integration = "export"
+  plugin_type = "git-export"
+  enabled     = %[2]s
+
+  config = {
+    url                   = "%[1]s"
+    dir                   = "/var/lib/rundeck/scm/terraform-acc-test-project-scm"
+    branch                = "main"
+    pathTemplate          = "$${job.group}$${job.name}-$${job.id}.$${config.format}"
+    format                = "yaml"
+    committerName         = "Terraform"
+    committerEmail        = "terraform@example.com"
+    strictHostKeyChecking = "no"
+  }
+}
+`
@@ -3,6 +3,11 @@ package rundeck
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/rundeck/go-rundeck/rundeck"
@@ -227,6 +232,474 @@ resource "rundeck_project" "test" {
 }
 `
 
+// TestAccProject_mergeConfig checks that in merge mode keys set outside
+// Terraform are preserved and not reported as drift, while removed declared
+// keys are deleted.
+func TestAccProject_mergeConfig(t *testing.T) {
+	var project rundeck.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectCheckDestroy(&project),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectConfig_mergeConfig, `"foo.bar" = "baz"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "config_management_mode", "merge"),
+					resource.TestCheckResourceAttr("rundeck_project.test", "extra_config.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					clients, err := getTestClients()
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := putProjectConfigKey(context.Background(), clients, "terraform-acc-test-merge-config", "team.owner", "payments"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: fmt.Sprintf(testAccProjectConfig_mergeConfig, `"foo.bar" = "qux"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "extra_config.%", "1"),
+					resource.TestCheckResourceAttr("rundeck_project.test", "extra_config.foo.bar", "qux"),
+					testAccProjectCheckConfigValue(&project, "team.owner", "payments"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectConfig_mergeConfig, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					testAccProjectCheckConfigValue(&project, "foo.bar", ""),
+					testAccProjectCheckConfigValue(&project, "team.owner", "payments"),
+				),
+			},
+			// Keys set outside Terraform must not show up as drift
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+			},
+		},
+	})
+}
+
+// testAccProjectCheckConfigValue checks a config key of the project loaded by
+// testAccProjectCheckExists. An empty expected value means the key is absent.
+func testAccProjectCheckConfigValue(project *rundeck.Project, key, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		projectConfig := project.Config.(map[string]interface{})
+		got, _ := projectConfig[key].(string)
+		if got != expected {
+			return fmt.Errorf("wrong %s config; expected %q, got %q", key, expected, got)
+		}
+		return nil
+	}
+}
+
+const testAccProjectConfig_mergeConfig = `
+resource "rundeck_project" "test" {
+  name                   = "terraform-acc-test-merge-config"
+  description            = "Test project for merge config management mode"
+  config_management_mode = "merge"
+
+  resource_model_source {
+    type = "local"
+  }
+
+  extra_config = {
+    %s
+  }
+}
+`
+
+// TestAccProject_nodeExecutorBlocks checks that the typed node executor and
+// file copier blocks are written to the plugin config keys and read back
+// without drift.
+func TestAccProject_nodeExecutorBlocks(t *testing.T) {
+	var project rundeck.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectCheckDestroy(&project),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_nodeExecutorBlocks,
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "default_node_executor_plugin", "jsch-ssh"),
+					resource.TestCheckResourceAttr("rundeck_project.test", "default_node_file_copier_plugin", "sshj-scp"),
+					resource.TestCheckResourceAttr("rundeck_project.test", "node_executor.ssh.connect_timeout", "30000"),
+					testAccProjectCheckConfigValue(&project, "service.NodeExecutor.default.config.keystoragepath", "keys/project/terraform-acc-test/id_rsa"),
+					testAccProjectCheckConfigValue(&project, "service.NodeExecutor.default.config.ssh-connect-timeout", "30000"),
+					testAccProjectCheckConfigValue(&project, "service.NodeExecutor.default.config.sudo-command-enabled", "true"),
+					testAccProjectCheckConfigValue(&project, "service.FileCopier.default.config.authentication", "privateKey"),
+				),
+			},
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+			},
+		},
+	})
+}
+
+const testAccProjectConfig_nodeExecutorBlocks = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-node-executor-blocks"
+  description = "Test project for typed node executor blocks"
+
+  node_executor {
+    ssh {
+      plugin               = "jsch-ssh"
+      key_storage_path     = "keys/project/terraform-acc-test/id_rsa"
+      connect_timeout      = 30000
+      sudo_command_enabled = true
+    }
+  }
+
+  file_copier {
+    ssh {
+      authentication = "privateKey"
+    }
+  }
+
+  resource_model_source {
+    type = "local"
+  }
+}
+`
+
+// TestAccProject_globalVariables checks that global_variables round-trips
+// through project.globals.<name> properties without drift.
+func TestAccProject_globalVariables(t *testing.T) {
+	var project rundeck.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectCheckDestroy(&project),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectConfig_globalVariables, `region = "eu-west-1"
+    tier   = "staging"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "global_variables.%", "2"),
+					resource.TestCheckResourceAttr("rundeck_project.test", "global_variables.region", "eu-west-1"),
+					resource.TestCheckNoResourceAttr("rundeck_project.test", "extra_config.project.globals.region"),
+					testAccProjectCheckConfigValue(&project, "project.globals.region", "eu-west-1"),
+					testAccProjectCheckConfigValue(&project, "project.globals.tier", "staging"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectConfig_globalVariables, `region = "eu-central-1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "global_variables.%", "1"),
+					testAccProjectCheckConfigValue(&project, "project.globals.region", "eu-central-1"),
+					testAccProjectCheckConfigValue(&project, "project.globals.tier", ""),
+				),
+			},
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+			},
+		},
+	})
+}
+
+const testAccProjectConfig_globalVariables = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-global-variables"
+  description = "Test project for global variables"
+
+  global_variables = {
+    %s
+  }
+
+  extra_config = {
+    "project.label" = "Global variables"
+  }
+
+  resource_model_source {
+    type = "local"
+  }
+}
+`
+
+// TestAccProject_executionHistoryCleanup checks that the cleanup block is
+// written to the project.execution.history.cleanup.* keys without drift.
+func TestAccProject_executionHistoryCleanup(t *testing.T) {
+	var project rundeck.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectCheckDestroy(&project),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_executionHistoryCleanup,
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "execution_history_cleanup.enabled", "true"),
+					resource.TestCheckResourceAttr("rundeck_project.test", "execution_history_cleanup.retention_days", "30"),
+					testAccProjectCheckConfigValue(&project, "project.execution.history.cleanup.enabled", "true"),
+					testAccProjectCheckConfigValue(&project, "project.execution.history.cleanup.retention.days", "30"),
+					testAccProjectCheckConfigValue(&project, "project.execution.history.cleanup.retention.minimum", "50"),
+					testAccProjectCheckConfigValue(&project, "project.execution.history.cleanup.batch", "500"),
+					testAccProjectCheckConfigValue(&project, "project.execution.history.cleanup.schedule", "0 0 3 * * ? *"),
+				),
+			},
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+			},
+		},
+	})
+}
+
+const testAccProjectConfig_executionHistoryCleanup = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-execution-cleanup"
+  description = "Test project for execution history cleanup"
+
+  execution_history_cleanup {
+    enabled            = true
+    retention_days     = 30
+    minimum_executions = 50
+    batch_size         = 500
+    schedule           = "0 0 3 * * ? *"
+  }
+
+  resource_model_source {
+    type = "local"
+  }
+}
+`
+
+// TestAccProject_modelSourceLabels checks that labeled sources renumbered
+// outside Terraform are matched back to their blocks, that sources are
+// reconstructed on import, and that stale sources are removed when the list
+// shrinks.
+func TestAccProject_modelSourceLabels(t *testing.T) {
+	var project rundeck.Project
+	projectName := "terraform-acc-test-source-labels"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectCheckDestroy(&project),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_modelSourceLabels,
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					testAccProjectCheckConfigValue(&project, "resources.source.1.label", "inventory"),
+					testAccProjectCheckConfigValue(&project, "resources.source.2.label", "local"),
+				),
+			},
+			// Swap the source numbers on the server; the plan must stay empty
+			{
+				PreConfig: func() {
+					clients, err := getTestClients()
+					if err != nil {
+						t.Fatal(err)
+					}
+					ctx := context.Background()
+					config, _, err := getProjectConfig(ctx, clients, projectName)
+					if err != nil {
+						t.Fatal(err)
+					}
+					swapped := map[string]string{}
+					for k, v := range config {
+						switch {
+						case strings.HasPrefix(k, "resources.source.1."):
+							k = "resources.source.2." + strings.TrimPrefix(k, "resources.source.1.")
+						case strings.HasPrefix(k, "resources.source.2."):
+							k = "resources.source.1." + strings.TrimPrefix(k, "resources.source.2.")
+						}
+						swapped[k] = v
+					}
+					if _, err := rundeckAPIRequest(ctx, clients, http.MethodPut, projectConfigPath(projectName), nil, swapped, nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccProjectConfig_modelSourceLabels,
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "rundeck_project.test",
+				ImportState:       true,
+				ImportStateId:     projectName,
+				ImportStateVerify: true,
+				// Imported sources follow the server numbering, which was swapped above
+				ImportStateVerifyIgnore: []string{"resource_model_source"},
+			},
+			{
+				Config: testAccProjectConfig_modelSourceSingle,
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "resource_model_source.#", "1"),
+					testAccProjectCheckConfigValue(&project, "resources.source.1.label", "local"),
+					testAccProjectCheckConfigValue(&project, "resources.source.2.type", ""),
+				),
+			},
+		},
+	})
+}
+
+const testAccProjectConfig_modelSourceLabels = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-source-labels"
+  description = "Test project for resource model source labels"
+
+  resource_model_source {
+    label = "inventory"
+    type  = "file"
+    config = {
+      format                    = "resourceyaml"
+      file                      = "/tmp/terraform-acc-tests-labels.yaml"
+      generateFileAutomatically = "true"
+    }
+  }
+
+  resource_model_source {
+    label = "local"
+    type  = "local"
+  }
+}
+`
+
+const testAccProjectConfig_modelSourceSingle = `
+resource "rundeck_project" "test" {
+  name        = "terraform-acc-test-source-labels"
+  description = "Test project for resource model source labels"
+
+  resource_model_source {
+    label = "local"
+    type  = "local"
+  }
+}
+`
+
+// TestAccProject_deletionProtection checks that a protected project cannot
+// be destroyed, and that the project is archived before it is deleted.
+func TestAccProject_deletionProtection(t *testing.T) {
+	var project rundeck.Project
+	archivePath := filepath.Join(t.TempDir(), "terraform-acc-test-deletion-protection.zip")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccProjectCheckDestroy(&project),
+			func(s *terraform.State) error {
+				info, err := os.Stat(archivePath)
+				if err != nil {
+					return fmt.Errorf("project archive was not written before destroy: %s", err)
+				}
+				if info.Size() == 0 {
+					return fmt.Errorf("project archive %s is empty", archivePath)
+				}
+				return nil
+			},
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccProjectConfig_deletionProtection, "true", archivePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccProjectConfig_deletionProtection, "true", archivePath),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("protected from deletion"),
+			},
+			{
+				Config: fmt.Sprintf(testAccProjectConfig_deletionProtection, "false", archivePath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProjectConfig_deletionProtection = `
+resource "rundeck_project" "test" {
+  name                    = "terraform-acc-test-deletion-protection"
+  description             = "Test project for deletion protection"
+  deletion_protection     = %s
+  archive_on_destroy_path = "%s"
+
+  resource_model_source {
+    type = "local"
+  }
+}
+`
+
+// TestAccProject_adoptExisting checks that a project created outside
+// Terraform is taken over on create when adopt_existing is set.
+func TestAccProject_adoptExisting(t *testing.T) {
+	var project rundeck.Project
+	projectName := "terraform-acc-test-adopt-existing"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccProjectCheckDestroy(&project),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					// Create client from environment variables for test verification
+					clients, err := getTestClients()
+					if err != nil {
+						t.Fatal(err)
+					}
+					ctx := context.Background()
+					if _, err := clients.V1.ProjectCreate(ctx, rundeck.ProjectCreateRequest{Name: &projectName}); err != nil {
+						t.Fatal(err)
+					}
+					if err := putProjectConfigKey(ctx, clients, projectName, "team.owner", "payments"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccProjectConfig_adoptExisting,
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectCheckExists("rundeck_project.test", &project),
+					resource.TestCheckResourceAttr("rundeck_project.test", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("rundeck_project.test", "description", "Adopted by Terraform"),
+					// Authoritative mode removes properties that are not declared
+					testAccProjectCheckConfigValue(&project, "team.owner", ""),
+				),
+			},
+			{
+				Config:   testAccProjectConfig_adoptExisting,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccProjectConfig_adoptExisting = `
+resource "rundeck_project" "test" {
+  name           = "terraform-acc-test-adopt-existing"
+  description    = "Adopted by Terraform"
+  adopt_existing = true
+
+  resource_model_source {
+    type = "local"
+  }
+}
+`
+
 const testAccProjectConfig_basic = `
 resource "rundeck_project" "main" {
   name = "terraform-acc-test-basic"
@@ -0,0 +1,207 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &systemExecutionModeResource{}
+	_ resource.ResourceWithConfigure   = &systemExecutionModeResource{}
+	_ resource.ResourceWithImportState = &systemExecutionModeResource{}
+)
+
+// systemExecutionModeID is the ID of the singleton execution mode resource.
+const systemExecutionModeID = "system"
+
+// NewSystemExecutionModeResource is a helper function to simplify the provider implementation.
+func NewSystemExecutionModeResource() resource.Resource {
+	return &systemExecutionModeResource{}
+}
+
+// systemExecutionModeResource is the resource implementation.
+type systemExecutionModeResource struct {
+	clients *RundeckClients
+}
+
+// systemExecutionModeResourceModel describes the resource data model.
+type systemExecutionModeResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Mode types.String `tfsdk:"mode"`
+}
+
+// systemInfoExecutions is the executions section of the system info response.
+// The generated V2 model declares "active" as a string while Rundeck returns
+// a boolean, so system info is decoded here instead.
+type systemInfoExecutions struct {
+	System struct {
+		Executions struct {
+			Active        bool   `json:"active"`
+			ExecutionMode string `json:"executionMode"`
+		} `json:"executions"`
+	} `json:"system"`
+}
+
+// Metadata returns the resource type name.
+func (r *systemExecutionModeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_execution_mode"
+}
+
+// Schema defines the schema for the resource.
+func (r *systemExecutionModeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the system-wide execution mode of a Rundeck server. In passive mode no jobs or ad-hoc commands are executed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Always \"system\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description: "Execution mode: active or passive.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "passive"),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *systemExecutionModeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*RundeckClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RundeckClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.clients = clients
+}
+
+// Create sets the execution mode and the initial Terraform state.
+func (r *systemExecutionModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan systemExecutionModeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setMode(plan.Mode.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the ID
+	plan.ID = types.StringValue(systemExecutionModeID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the mode reported by system info.
+func (r *systemExecutionModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state systemExecutionModeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var info systemInfoExecutions
+	if _, err := rundeckAPIRequest(ctx, r.clients, http.MethodGet, "system/info", nil, nil, &info); err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading execution mode",
+			fmt.Sprintf("Could not read system info: %s", err.Error()),
+		)
+		return
+	}
+
+	mode := info.System.Executions.ExecutionMode
+	if mode == "" {
+		// Older servers only report the active flag
+		mode = "passive"
+		if info.System.Executions.Active {
+			mode = "active"
+		}
+	}
+
+	// Update the state
+	state.ID = types.StringValue(systemExecutionModeID)
+	state.Mode = types.StringValue(mode)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update sets the new execution mode.
+func (r *systemExecutionModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan systemExecutionModeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setMode(plan.Mode.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(systemExecutionModeID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from state only. The server keeps its current
+// mode, so destroying the resource can never activate a passive server.
+func (r *systemExecutionModeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *systemExecutionModeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), systemExecutionModeID)...)
+}
+
+// setMode enables (active) or disables (passive) executions.
+func (r *systemExecutionModeResource) setMode(mode string, diags *diag.Diagnostics) {
+	var httpResp *http.Response
+	var err error
+	if mode == "active" {
+		_, httpResp, err = r.clients.V2.SystemAPI.ApiExecutionModeActive(r.clients.ctx).Execute()
+	} else {
+		_, httpResp, err = r.clients.V2.SystemAPI.ApiExecutionModePassive(r.clients.ctx).Execute()
+	}
+	if err != nil {
+		diags.AddError(
+			"Error setting execution mode",
+			fmt.Sprintf("Could not set execution mode to %s: %s", mode, openAPIErrorDetail(err, httpResp)),
+		)
+	}
+}
@@ -0,0 +1,64 @@
+package rundeck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSystemExecutionMode_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccSystemExecutionModeConfig, "passive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rundeck_system_execution_mode.test", "id", "system"),
+					testAccSystemExecutionModeCheck("passive"),
+				),
+			},
+			{
+				// End in active mode so the server stays usable for other tests
+				Config: fmt.Sprintf(testAccSystemExecutionModeConfig, "active"),
+				Check:  testAccSystemExecutionModeCheck("active"),
+			},
+			{
+				ResourceName:      "rundeck_system_execution_mode.test",
+				ImportState:       true,
+				ImportStateId:     "system",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSystemExecutionModeCheck(expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Create client from environment variables for test verification
+		clients, err := getTestClients()
+		if err != nil {
+			return fmt.Errorf("failed to create test client: %s", err)
+		}
+
+		var info systemInfoExecutions
+		if _, err := rundeckAPIRequest(context.Background(), clients, http.MethodGet, "system/info", nil, nil, &info); err != nil {
+			return fmt.Errorf("Error reading system info: %s", err)
+		}
+		if got := info.System.Executions.ExecutionMode; got != expected {
+			return fmt.Errorf("expected execution mode %s, got %s", expected, got)
+		}
+
+		return nil
+	}
+}
+
+const testAccSystemExecutionModeConfig = `
+resource "rundeck_system_execution_mode" "test" {
+  mode = "%s"
+}
+`
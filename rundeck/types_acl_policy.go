@@ -0,0 +1,171 @@
+package rundeck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ basetypes.StringTypable                    = (*AclPolicyType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*AclPolicyValue)(nil)
+)
+
+// AclPolicyType is a custom type for ACL policy YAML
+// It implements semantic equality so policies are considered equal when
+// their YAML documents have the same structure, regardless of formatting
+type AclPolicyType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name
+func (t AclPolicyType) String() string {
+	return "AclPolicyType"
+}
+
+// ValueFromString creates an AclPolicyValue from a StringValue
+func (t AclPolicyType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return AclPolicyValue{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform creates a value from Terraform data
+func (t AclPolicyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return AclPolicyValue{
+		StringValue: stringValue,
+	}, nil
+}
+
+// Equal checks if two types are equal
+func (t AclPolicyType) Equal(o attr.Type) bool {
+	other, ok := o.(AclPolicyType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueType returns the value type for this type
+func (t AclPolicyType) ValueType(ctx context.Context) attr.Value {
+	return AclPolicyValue{}
+}
+
+// AclPolicyValue is the value type for ACL policy YAML
+type AclPolicyValue struct {
+	basetypes.StringValue
+}
+
+// NewAclPolicyValue creates a known AclPolicyValue
+func NewAclPolicyValue(value string) AclPolicyValue {
+	return AclPolicyValue{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// Type returns the type
+func (v AclPolicyValue) Type(ctx context.Context) attr.Type {
+	return AclPolicyType{}
+}
+
+// Equal implements standard equality (delegates to base StringValue)
+func (v AclPolicyValue) Equal(o attr.Value) bool {
+	other, ok := o.(AclPolicyValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals implements semantic equality for ACL policies
+// Two policies are semantically equal if every YAML document decodes to the
+// same structure. This prevents diffs from whitespace, comments, quoting,
+// flow/block style or key order changes, including reformatting by Rundeck
+func (v AclPolicyValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Convert the new value to AclPolicyValue
+	newValue, ok := newValuable.(AclPolicyValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	// Handle null/unknown values with standard equality
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.Equal(newValue), diags
+	}
+
+	return aclPoliciesEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
+
+// aclPoliciesEquivalent reports whether two policy strings contain the same
+// YAML documents. Policies that cannot be parsed are compared as plain text.
+func aclPoliciesEquivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	aDocs, err := decodeAclPolicyDocuments(a)
+	if err != nil {
+		return false
+	}
+	bDocs, err := decodeAclPolicyDocuments(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aDocs, bDocs)
+}
+
+// decodeAclPolicyDocuments decodes every non-empty YAML document of a policy.
+func decodeAclPolicyDocuments(policy string) ([]interface{}, error) {
+	var docs []interface{}
+
+	decoder := yaml.NewDecoder(strings.NewReader(policy))
+	for {
+		var doc interface{}
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Skip empty documents (e.g. a trailing "---")
+		if doc == nil {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
@@ -0,0 +1,74 @@
+package rundeck
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAclPolicyValue_StringSemanticEquals(t *testing.T) {
+	cases := []struct {
+		name  string
+		old   string
+		new   string
+		equal bool
+	}{
+		{
+			name:  "identical",
+			old:   aclPolicyInitial,
+			new:   aclPolicyInitial,
+			equal: true,
+		},
+		{
+			name: "formatting, comments and key order",
+			old:  aclPolicyInitial,
+			new: `# reformatted
+by:
+  group: "test-group"
+context: {application: rundeck}
+description: Initial ACL policy for testing updates.
+for:
+  resource:
+  - allow:
+    - read
+    equals: {kind: system}
+`,
+			equal: true,
+		},
+		{
+			name:  "trailing document separator",
+			old:   aclPolicyInitial + "\n---\n" + aclPolicyUpdated,
+			new:   aclPolicyInitial + "\n---\n" + aclPolicyUpdated + "\n---\n",
+			equal: true,
+		},
+		{
+			name:  "different action",
+			old:   aclPolicyInitial,
+			new:   aclPolicyUpdated,
+			equal: false,
+		},
+		{
+			name:  "document order",
+			old:   aclPolicyInitial + "\n---\n" + aclPolicyUpdated,
+			new:   aclPolicyUpdated + "\n---\n" + aclPolicyInitial,
+			equal: false,
+		},
+		{
+			name:  "invalid yaml",
+			old:   aclPolicyInitial,
+			new:   "description: [",
+			equal: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			equal, diags := NewAclPolicyValue(tc.old).StringSemanticEquals(context.Background(), NewAclPolicyValue(tc.new))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != tc.equal {
+				t.Errorf("expected semantic equality %t, got %t", tc.equal, equal)
+			}
+		})
+	}
+}